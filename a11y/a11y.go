@@ -0,0 +1,307 @@
+// a11y checks rendered vecty-material component trees for common accessibility
+// problems. It checks the DOM, not the vecty markup, so it sees the result of
+// every component and the MDC library together.
+//
+// In tests, check a mounted component with Check, or with
+// materialtest.AssertAccessible. During development, Watch reports problems as
+// console warnings while the app runs:
+//
+//	if devMode {
+//		a11y.Watch(js.Global().Get("document").Get("body"))
+//	}
+//
+// Whether an element is hidden is decided from its computed style. Without the
+// MDC stylesheets, as in an emulated DOM, elements that CSS would hide, such as
+// a closed dialog, are checked as if they were visible.
+package a11y // import "github.com/vecty-material/material/a11y"
+
+import (
+	"errors"
+	"strings"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs"
+)
+
+// Rules reported in an Issue.
+const (
+	// RuleName is reported for an interactive element, image or dialog that
+	// has no accessible name.
+	RuleName = "accessible-name"
+
+	// RuleIDRef is reported for an ARIA attribute or label for attribute that
+	// references an id no element has.
+	RuleIDRef = "id-reference"
+
+	// RuleRole is reported for a role attribute that is not a WAI-ARIA role.
+	RuleRole = "role"
+
+	// RuleHiddenFocusable is reported for an element that can be reached with
+	// the keyboard, but is hidden from assistive technology by aria-hidden.
+	RuleHiddenFocusable = "hidden-focusable"
+
+	// RuleLabel is reported for a form control without a label, and for a
+	// label that is not associated with any control.
+	RuleLabel = "label"
+)
+
+// Issue is an accessibility problem found by Check.
+type Issue struct {
+	Rule    string
+	Element js.Value
+	Message string
+}
+
+// String returns the issue in the form "<element>: rule: message".
+func (i Issue) String() string {
+	return describe(i.Element) + ": " + i.Rule + ": " + i.Message
+}
+
+// idRefAttrs are the attributes that hold space separated id references.
+var idRefAttrs = []string{
+	"aria-activedescendant",
+	"aria-controls",
+	"aria-describedby",
+	"aria-labelledby",
+	"aria-owns",
+	"for",
+}
+
+// namedRoles are the roles that require an accessible name.
+var namedRoles = map[string]bool{
+	"alertdialog": true, "button": true, "checkbox": true, "dialog": true,
+	"img": true, "link": true, "menuitem": true, "menuitemcheckbox": true,
+	"menuitemradio": true, "option": true, "radio": true, "slider": true,
+	"switch": true, "tab": true, "textbox": true,
+}
+
+// Check returns the accessibility issues of root and the elements inside it,
+// in document order.
+func Check(root js.Value) (issues []Issue, err error) {
+	defer gojs.CatchException(&err)
+	if root.IsUndefined() || root.IsNull() {
+		return nil, errors.New("root is nil.")
+	}
+	c := &checker{doc: root.Get("ownerDocument")}
+	elems := []js.Value{root}
+	all := root.Call("querySelectorAll", "*")
+	for i := 0; i < all.Get("length").Int(); i++ {
+		elems = append(elems, all.Call("item", i))
+	}
+	for _, e := range elems {
+		c.check(e)
+	}
+	return c.issues, err
+}
+
+type checker struct {
+	doc    js.Value
+	issues []Issue
+}
+
+func (c *checker) report(e js.Value, rule, msg string) {
+	c.issues = append(c.issues, Issue{Rule: rule, Element: e, Message: msg})
+}
+
+func (c *checker) check(e js.Value) {
+	for _, attr := range idRefAttrs {
+		if !e.Call("hasAttribute", attr).Bool() {
+			continue
+		}
+		for _, id := range strings.Fields(attribute(e, attr)) {
+			if c.doc.Call("getElementById", id).IsNull() {
+				c.report(e, RuleIDRef, attr+` references missing id "`+id+`"`)
+			}
+		}
+	}
+
+	role := explicitRole(e)
+	if role != "" && !validRoles[role] {
+		c.report(e, RuleRole, `"`+role+`" is not a WAI-ARIA role`)
+	}
+
+	if focusable(e) && ariaHidden(e) && !hidden(e) {
+		c.report(e, RuleHiddenFocusable,
+			"focusable element is inside aria-hidden content")
+	}
+
+	switch tag := tagName(e); {
+	case tag == "label":
+		if e.Get("control").IsNull() {
+			c.report(e, RuleLabel, "label is not associated with a control")
+		}
+		return
+	case formControl(e):
+		if c.name(e) == "" {
+			c.report(e, RuleLabel, "form control has no label")
+		}
+		return
+	}
+
+	if role == "presentation" || role == "none" {
+		return
+	}
+	if r := implicitOr(e, role); namedRoles[r] && c.name(e) == "" {
+		c.report(e, RuleName, r+" has no accessible name")
+	}
+}
+
+// name returns a simplified accessible name of e.
+func (c *checker) name(e js.Value) string {
+	if ids := strings.Fields(attribute(e, "aria-labelledby")); len(ids) > 0 {
+		var parts []string
+		for _, id := range ids {
+			if l := c.doc.Call("getElementById", id); !l.IsNull() {
+				parts = append(parts, text(l))
+			}
+		}
+		if n := strings.TrimSpace(strings.Join(parts, " ")); n != "" {
+			return n
+		}
+	}
+	if n := strings.TrimSpace(attribute(e, "aria-label")); n != "" {
+		return n
+	}
+	if formControl(e) {
+		if labels := e.Get("labels"); !labels.IsUndefined() &&
+			!labels.IsNull() {
+			for i := 0; i < labels.Get("length").Int(); i++ {
+				if n := text(labels.Call("item", i)); n != "" {
+					return n
+				}
+			}
+		}
+	}
+	if tagName(e) == "img" {
+		if n := strings.TrimSpace(attribute(e, "alt")); n != "" {
+			return n
+		}
+	}
+	if n := strings.TrimSpace(attribute(e, "title")); n != "" {
+		return n
+	}
+	switch implicitOr(e, explicitRole(e)) {
+	case "dialog", "alertdialog", "img", "textbox", "slider":
+		// Not named by their content.
+		return ""
+	}
+	return text(e)
+}
+
+// implicitOr returns role, or the implicit role of e if role is empty.
+func implicitOr(e js.Value, role string) string {
+	if role != "" {
+		return role
+	}
+	switch tagName(e) {
+	case "button":
+		return "button"
+	case "a":
+		if e.Call("hasAttribute", "href").Bool() {
+			return "link"
+		}
+	case "img":
+		if !e.Call("hasAttribute", "alt").Bool() ||
+			attribute(e, "alt") != "" {
+			return "img"
+		}
+		return "presentation"
+	}
+	return ""
+}
+
+// explicitRole returns the first token of e's role attribute.
+func explicitRole(e js.Value) string {
+	f := strings.Fields(attribute(e, "role"))
+	if len(f) == 0 {
+		return ""
+	}
+	return f[0]
+}
+
+func formControl(e js.Value) bool {
+	switch tagName(e) {
+	case "select", "textarea":
+		return true
+	case "input":
+		switch strings.ToLower(e.Get("type").String()) {
+		case "hidden", "submit", "reset", "button", "image":
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// focusable reports whether e can be reached with the Tab key.
+func focusable(e js.Value) bool {
+	if ti := attribute(e, "tabindex"); ti != "" {
+		return !strings.HasPrefix(strings.TrimSpace(ti), "-")
+	}
+	if e.Get("disabled").Truthy() {
+		return false
+	}
+	switch tagName(e) {
+	case "button", "select", "textarea":
+		return true
+	case "input":
+		return strings.ToLower(e.Get("type").String()) != "hidden"
+	case "a":
+		return e.Call("hasAttribute", "href").Bool()
+	}
+	return false
+}
+
+// ariaHidden reports whether e or an ancestor has aria-hidden="true".
+func ariaHidden(e js.Value) bool {
+	return !e.Call("closest", `[aria-hidden="true"]`).IsNull()
+}
+
+// hidden reports whether e is not rendered according to the computed styles of
+// e and its ancestors.
+func hidden(e js.Value) bool {
+	view := e.Get("ownerDocument").Get("defaultView")
+	if view.IsNull() || view.IsUndefined() {
+		return false
+	}
+	for ; !e.IsNull(); e = e.Get("parentElement") {
+		style := view.Call("getComputedStyle", e)
+		if style.Get("display").String() == "none" ||
+			style.Get("visibility").String() == "hidden" {
+			return true
+		}
+	}
+	return false
+}
+
+func text(e js.Value) string {
+	return strings.Join(strings.Fields(e.Get("textContent").String()), " ")
+}
+
+func tagName(e js.Value) string {
+	return strings.ToLower(e.Get("tagName").String())
+}
+
+// attribute returns the attribute name of e, or an empty string if it is not set.
+func attribute(e js.Value, name string) string {
+	v := e.Call("getAttribute", name)
+	if v.IsNull() {
+		return ""
+	}
+	return v.String()
+}
+
+func describe(e js.Value) string {
+	if e.IsNull() || e.IsUndefined() {
+		return "<nil>"
+	}
+	s := "<" + tagName(e)
+	if id := e.Get("id").String(); id != "" {
+		s += "#" + id
+	}
+	if c := attribute(e, "class"); c != "" {
+		s += "." + strings.Join(strings.Fields(c), ".")
+	}
+	return s + ">"
+}
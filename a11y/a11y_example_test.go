@@ -0,0 +1,47 @@
+package a11y_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/vecty-material/material/a11y"
+	"github.com/vecty-material/material/gojs/jsdom"
+)
+
+func ExampleCheck() {
+	dom, err := jsdom.New(`<div id="app">
+  <button class="mdc-button"></button>
+  <div class="mdc-form-field">
+    <div class="mdc-checkbox">
+      <input type="checkbox" class="mdc-checkbox__native-control">
+    </div>
+    <label>Subscribe</label>
+  </div>
+  <aside class="mdc-dialog" role="dialog" aria-labelledby="missing"></aside>
+  <span role="buton" aria-label="Close"></span>
+  <ul aria-hidden="true">
+    <li role="menuitem" tabindex="0">Back</li>
+  </ul>
+</div>`, nil)
+	if err != nil {
+		log.Fatalf("Unable to create DOM: %v\n", err)
+	}
+	defer dom.Close()
+
+	issues, err := a11y.Check(dom.Document().Call("getElementById", "app"))
+	if err != nil {
+		log.Fatalf("Unable to check DOM: %v\n", err)
+	}
+	for _, i := range issues {
+		fmt.Println(i)
+	}
+
+	// Output:
+	// <button.mdc-button>: accessible-name: button has no accessible name
+	// <input.mdc-checkbox__native-control>: label: form control has no label
+	// <label>: label: label is not associated with a control
+	// <aside.mdc-dialog>: id-reference: aria-labelledby references missing id "missing"
+	// <aside.mdc-dialog>: accessible-name: dialog has no accessible name
+	// <span>: role: "buton" is not a WAI-ARIA role
+	// <li>: hidden-focusable: focusable element is inside aria-hidden content
+}
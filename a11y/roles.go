@@ -0,0 +1,24 @@
+package a11y
+
+// validRoles are the WAI-ARIA 1.1 roles that may be used in a role attribute.
+var validRoles = map[string]bool{
+	"alert": true, "alertdialog": true, "application": true, "article": true,
+	"banner": true, "button": true, "cell": true, "checkbox": true,
+	"columnheader": true, "combobox": true, "complementary": true,
+	"contentinfo": true, "definition": true, "dialog": true,
+	"directory": true, "document": true, "feed": true, "figure": true,
+	"form": true, "grid": true, "gridcell": true, "group": true,
+	"heading": true, "img": true, "link": true, "list": true,
+	"listbox": true, "listitem": true, "log": true, "main": true,
+	"marquee": true, "math": true, "menu": true, "menubar": true,
+	"menuitem": true, "menuitemcheckbox": true, "menuitemradio": true,
+	"navigation": true, "none": true, "note": true, "option": true,
+	"presentation": true, "progressbar": true, "radio": true,
+	"radiogroup": true, "region": true, "row": true, "rowgroup": true,
+	"rowheader": true, "scrollbar": true, "search": true, "searchbox": true,
+	"separator": true, "slider": true, "spinbutton": true, "status": true,
+	"switch": true, "tab": true, "table": true, "tablist": true,
+	"tabpanel": true, "term": true, "textbox": true, "timer": true,
+	"toolbar": true, "tooltip": true, "tree": true, "treegrid": true,
+	"treeitem": true,
+}
@@ -0,0 +1,67 @@
+package a11y
+
+import "syscall/js"
+
+// Warn checks root and writes each issue found to the console as a warning.
+func Warn(root js.Value) error {
+	issues, err := Check(root)
+	for _, i := range issues {
+		warn(i)
+	}
+	return err
+}
+
+// Watch checks root now and again whenever the DOM inside it changes, and
+// writes issues to the console as warnings. An issue is only reported once,
+// until it has been fixed. Call the returned function to stop watching.
+func Watch(root js.Value) (stop func()) {
+	reported := map[string]bool{}
+	timeout := js.Null()
+	var run, observe js.Func
+	run = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		timeout = js.Null()
+		issues, err := Check(root)
+		if err != nil {
+			js.Global().Get("console").Call("warn", "a11y: "+err.Error())
+			return nil
+		}
+		current := map[string]bool{}
+		for _, i := range issues {
+			s := i.String()
+			current[s] = true
+			if !reported[s] {
+				warn(i)
+			}
+		}
+		reported = current
+		return nil
+	})
+	observe = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		// Batch the mutations of a render into a single check.
+		if timeout.IsNull() {
+			timeout = js.Global().Call("setTimeout", run, 100)
+		}
+		return nil
+	})
+	observer := js.Global().Get("MutationObserver").New(observe)
+	observer.Call("observe", root, map[string]interface{}{
+		"attributes":    true,
+		"childList":     true,
+		"characterData": true,
+		"subtree":       true,
+	})
+	run.Invoke()
+
+	return func() {
+		observer.Call("disconnect")
+		if !timeout.IsNull() {
+			js.Global().Call("clearTimeout", timeout)
+		}
+		observe.Release()
+		run.Release()
+	}
+}
+
+func warn(i Issue) {
+	js.Global().Get("console").Call("warn", "a11y: "+i.String(), i.Element)
+}
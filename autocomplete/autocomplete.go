@@ -0,0 +1,327 @@
+// autocomplete implements a combobox suggesting values from a Go data source as
+// the user types in a textfield.
+package autocomplete // import "github.com/vecty-material/material/autocomplete"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/menu"
+	"github.com/vecty-material/material/textfield"
+	"github.com/vecty-material/material/ul"
+)
+
+// A is a vecty-material autocomplete component. It renders a textfield and,
+// anchored below it, a menu of the suggestions Source returns for the text the
+// user typed, with the matching part of each suggestion highlighted. The arrow
+// keys move through the suggestions, Enter selects one and Escape closes the
+// menu, while focus stays in the textfield.
+//
+// The menu is rendered CSS only: the MDC menu component would move focus to
+// its items when opened.
+//
+// By default the user typing sets Value to the text, as does selecting a
+// suggestion. If MustMatch is set, Value is only set by selecting a
+// suggestion, or by typing one exactly, and the text reverts to Value when the
+// user leaves the field. OnChange is called when Value changes.
+type A struct {
+	vecty.Core
+	Root       vecty.MarkupOrChild `vecty:"prop"`
+	Input      vecty.MarkupOrChild `vecty:"prop"`
+	Label      string              `vecty:"prop"`
+	HelperText string              `vecty:"prop"`
+	Value      string              `vecty:"prop"`
+
+	// Source returns the suggestions for query. Filter builds a Source from a
+	// list of options.
+	Source func(query string) []string `vecty:"prop"`
+
+	// Debounce, if non-zero, makes Source asynchronous: it is called in a
+	// goroutine once the user has stopped typing for Debounce, and may block,
+	// for example to query a server. Results for an earlier query are
+	// dropped.
+	Debounce time.Duration `vecty:"prop"`
+
+	// MaxSuggestions limits the number of suggestions shown, if non-zero.
+	MaxSuggestions int `vecty:"prop"`
+
+	MustMatch bool                        `vecty:"prop"`
+	OnChange  func(this *A, value string) `vecty:"prop"`
+
+	autoID      string
+	text        string
+	value       string
+	suggestions []string
+	query       string
+	highlighted int
+	open        bool
+	seq         int
+	timer       *time.Timer
+}
+
+// Filter returns a Source suggesting the options containing the query, ignoring
+// case, with the options starting with it first. An empty query matches every
+// option.
+func Filter(options []string) func(query string) []string {
+	return func(query string) []string {
+		q := strings.ToLower(query)
+		var prefix, other []string
+		for _, o := range options {
+			switch i := strings.Index(strings.ToLower(o), q); {
+			case i == 0:
+				prefix = append(prefix, o)
+			case i > 0:
+				other = append(other, o)
+			}
+		}
+		return append(prefix, other...)
+	}
+}
+
+// Render implements the vecty.Component interface.
+func (c *A) Render() vecty.ComponentOrHTML {
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	if c.Value != c.value {
+		// Value was changed by the parent.
+		c.text = c.Value
+		c.value = c.Value
+	}
+	id := base.AutoID(&c.autoID)
+	open := c.open && len(c.suggestions) > 0
+
+	items := make([]vecty.ComponentOrHTML, len(c.suggestions))
+	for i, s := range c.suggestions {
+		i := i
+		items[i] = &ul.Item{
+			Root: vecty.Markup(
+				prop.ID(optionID(id, i)),
+				vecty.Attribute("role", "option"),
+				vecty.Attribute("tabindex", -1),
+				vecty.Attribute("aria-selected", i == c.highlighted),
+				// Selecting on mousedown keeps the focus in the textfield.
+				event.MouseDown(func(e *vecty.Event) {
+					c.selectSuggestion(i)
+				}).PreventDefault(),
+			),
+			Primary:  highlight(s, c.query),
+			Selected: i == c.highlighted,
+		}
+	}
+
+	niMarkup := base.MarkupOnly(c.Input)
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("mdc-menu-anchor"),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+		),
+		&textfield.TF{
+			Label:      c.Label,
+			HelperText: c.HelperText,
+			Value:      c.text,
+			Input: vecty.Markup(
+				vecty.MarkupIf(niMarkup != nil, niMarkup),
+				vecty.Attribute("role", "combobox"),
+				vecty.Attribute("autocomplete", "off"),
+				vecty.Attribute("aria-autocomplete", "list"),
+				vecty.Attribute("aria-expanded", open),
+				vecty.Attribute("aria-owns", id+"-listbox"),
+				vecty.MarkupIf(open && c.highlighted >= 0,
+					vecty.Attribute("aria-activedescendant",
+						optionID(id, c.highlighted))),
+				event.KeyDown(c.onKeyDown),
+				event.Blur(c.onBlur),
+			),
+			OnInput: c.onInput,
+		},
+		&menu.M{
+			Root: vecty.Markup(applyer.CSSOnly()),
+			Open: open,
+			List: &ul.L{
+				Root: vecty.Markup(
+					prop.ID(id+"-listbox"),
+					vecty.Attribute("role", "listbox"),
+				),
+				Items: items,
+			},
+		},
+	)
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (c *A) Unmount() {
+	// Drop the results of pending queries.
+	c.seq++
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+}
+
+func (c *A) onInput(tf *textfield.TF, e *vecty.Event) {
+	c.text = tf.Value
+	if !c.MustMatch {
+		c.setValue(c.text)
+	}
+	c.search(c.text)
+	vecty.Rerender(c)
+}
+
+func (c *A) onKeyDown(e *vecty.Event) {
+	n := len(c.suggestions)
+	switch e.Get("key").String() {
+	case "ArrowDown":
+		e.Call("preventDefault")
+		if !c.open || n == 0 {
+			c.search(c.text)
+			break
+		}
+		c.highlighted = (c.highlighted + 1) % n
+	case "ArrowUp":
+		e.Call("preventDefault")
+		if !c.open || n == 0 {
+			break
+		}
+		c.highlighted--
+		if c.highlighted < 0 {
+			c.highlighted = n - 1
+		}
+	case "Enter":
+		if c.open && c.highlighted >= 0 && c.highlighted < n {
+			// Do not submit the form the autocomplete is in.
+			e.Call("preventDefault")
+			c.selectSuggestion(c.highlighted)
+			return
+		}
+	case "Escape":
+		c.close()
+	default:
+		return
+	}
+	vecty.Rerender(c)
+}
+
+func (c *A) onBlur(e *vecty.Event) {
+	if c.MustMatch {
+		c.matchText()
+		c.text = c.Value
+	}
+	c.close()
+	vecty.Rerender(c)
+}
+
+// search shows the suggestions for query, calling Source right away or, if
+// Debounce is set, once the user has stopped typing.
+func (c *A) search(query string) {
+	if c.Source == nil {
+		return
+	}
+	c.seq++
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	if c.Debounce == 0 {
+		c.show(query, c.Source(query))
+		return
+	}
+	seq := c.seq
+	c.timer = time.AfterFunc(c.Debounce, func() {
+		suggestions := c.Source(query)
+		if seq != c.seq {
+			return
+		}
+		c.show(query, suggestions)
+		vecty.Rerender(c)
+	})
+}
+
+func (c *A) show(query string, suggestions []string) {
+	if c.MaxSuggestions > 0 && len(suggestions) > c.MaxSuggestions {
+		suggestions = suggestions[:c.MaxSuggestions]
+	}
+	c.query = query
+	c.suggestions = suggestions
+	c.highlighted = -1
+	c.open = true
+	if c.MustMatch && query == c.text {
+		// Typing a suggestion exactly selects it. With Debounce the
+		// suggestions for the text are only known now.
+		c.matchText()
+	}
+}
+
+func (c *A) close() {
+	c.seq++
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	c.open = false
+	c.highlighted = -1
+}
+
+func (c *A) selectSuggestion(i int) {
+	c.text = c.suggestions[i]
+	c.setValue(c.text)
+	c.close()
+	vecty.Rerender(c)
+}
+
+func (c *A) setValue(value string) {
+	if value == c.Value {
+		return
+	}
+	c.Value = value
+	c.value = value
+	if c.OnChange != nil {
+		c.OnChange(c, value)
+	}
+}
+
+// matchText sets Value to the suggestion equal to the text, ignoring case, if
+// there is one.
+func (c *A) matchText() {
+	if i := c.matchIndex(); i >= 0 {
+		c.setValue(c.suggestions[i])
+	}
+}
+
+// matchIndex returns the index of the suggestion equal to the text, ignoring
+// case, or -1 if there is none.
+func (c *A) matchIndex() int {
+	for i, s := range c.suggestions {
+		if strings.EqualFold(s, c.text) {
+			return i
+		}
+	}
+	return -1
+}
+
+func optionID(id string, i int) string {
+	return id + "-option-" + strconv.Itoa(i)
+}
+
+// highlight returns s with the first match of query, ignoring case, in bold.
+func highlight(s, query string) vecty.ComponentOrHTML {
+	ls, lq := strings.ToLower(s), strings.ToLower(query)
+	i := strings.Index(ls, lq)
+	// If lower casing changed byte offsets the match cannot be located.
+	if query == "" || i < 0 || len(ls) != len(s) || len(lq) != len(query) {
+		return vecty.Text(s)
+	}
+	j := i + len(query)
+	return elem.Span(
+		vecty.Text(s[:i]),
+		elem.Strong(vecty.Text(s[i:j])),
+		vecty.Text(s[j:]),
+	)
+}
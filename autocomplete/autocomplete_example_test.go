@@ -0,0 +1,67 @@
+package autocomplete_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/autocomplete"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func Example() {
+	a := &autocomplete.A{
+		Input:     vecty.Markup(prop.ID("fruit")),
+		Label:     "Fruit",
+		Source:    autocomplete.Filter([]string{"Apple", "Apricot", "Banana", "Pineapple"}),
+		MustMatch: true,
+		OnChange: func(this *autocomplete.A, value string) {
+			fmt.Printf("Value: %s\n", value)
+		},
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("fruit")
+
+	materialtest.Focus(input)
+	materialtest.Input(input, "ap")
+	for _, o := range s.ByRole("option") {
+		fmt.Println(o.Get("textContent"))
+	}
+
+	// The arrow keys highlight a suggestion, and Enter selects it.
+	materialtest.PressKey(input, "ArrowDown")
+	materialtest.PressKey(input, "ArrowDown")
+	fmt.Printf("Highlighted: %v\n", s.ByID(
+		input.Call("getAttribute", "aria-activedescendant").String()).
+		Get("textContent"))
+	materialtest.PressKey(input, "Enter")
+	fmt.Printf("Text: %v\n", input.Get("value"))
+
+	// Text that matches no suggestion reverts when the user leaves the field.
+	materialtest.Input(input, "Cherry")
+	materialtest.Blur(input)
+	fmt.Printf("Text: %v\n", input.Get("value"))
+
+	// Output:
+	// Apple
+	// Apricot
+	// Pineapple
+	// Highlighted: Apricot
+	// Value: Apricot
+	// Text: Apricot
+	// Text: Apricot
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package autocomplete_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/autocomplete"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"closed", &autocomplete.A{Label: "Fruit", Value: "Apple", Source: autocomplete.Filter([]string{"Apple", "Banana"})}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
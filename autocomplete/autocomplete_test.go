@@ -0,0 +1,114 @@
+package autocomplete_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/autocomplete"
+	"github.com/vecty-material/material/materialtest"
+)
+
+// app renders the autocomplete returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestCombobox(t *testing.T) {
+	value := ""
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &autocomplete.A{
+			Input:  vecty.Markup(prop.ID("fruit")),
+			Label:  "Fruit",
+			Value:  value,
+			Source: autocomplete.Filter([]string{"Apple", "Apricot", "Banana"}),
+			OnChange: func(this *autocomplete.A, v string) {
+				value = v
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("fruit")
+	menu := s.ByClass("mdc-menu")[0]
+
+	materialtest.AssertAria(t, input, "expanded", "false")
+	materialtest.AssertNoClass(t, menu, "mdc-menu--open")
+
+	materialtest.Focus(input)
+	materialtest.Input(input, "ap")
+	options := s.ByRole("option")
+	if len(options) != 2 {
+		t.Fatalf("got %d options, want 2", len(options))
+	}
+	materialtest.AssertAria(t, input, "expanded", "true")
+	materialtest.AssertClass(t, menu, "mdc-menu--open")
+	materialtest.AssertNoAttr(t, input, "aria-activedescendant")
+
+	for i, o := range options {
+		materialtest.PressKey(input, "ArrowDown")
+		materialtest.AssertAttr(t, input, "aria-activedescendant",
+			o.Get("id").String())
+		materialtest.AssertAria(t, o, "selected", "true")
+		materialtest.AssertClass(t, o, "mdc-list-item--selected")
+		materialtest.AssertAria(t, options[1-i], "selected", "false")
+	}
+
+	materialtest.PressKey(input, "Escape")
+	materialtest.AssertAria(t, input, "expanded", "false")
+	materialtest.AssertNoClass(t, menu, "mdc-menu--open")
+	materialtest.AssertNoAttr(t, input, "aria-activedescendant")
+	if value != "ap" {
+		t.Errorf("value = %q, want %q", value, "ap")
+	}
+
+	// Rendering the autocomplete with a new Value replaces the text.
+	value = "Banana"
+	vecty.Rerender(a)
+	materialtest.AssertProp(t, input, "value", "Banana")
+}
+
+func TestMustMatchDebounce(t *testing.T) {
+	changes := make(chan string, 1)
+	a := &autocomplete.A{
+		Input:     vecty.Markup(prop.ID("fruit")),
+		Source:    autocomplete.Filter([]string{"Apple", "Apricot"}),
+		Debounce:  time.Millisecond,
+		MustMatch: true,
+		OnChange: func(this *autocomplete.A, v string) {
+			changes <- v
+		},
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+
+	// The suggestions arrive after the input, and typing one exactly selects
+	// it once they do.
+	materialtest.Input(s.ByID("fruit"), "apple")
+	select {
+	case v := <-changes:
+		if v != "Apple" {
+			t.Errorf("OnChange value = %q, want %q", v, "Apple")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnChange not called")
+	}
+	if a.Value != "Apple" {
+		t.Errorf("Value = %q, want %q", a.Value, "Apple")
+	}
+}
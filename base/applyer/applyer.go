@@ -1,7 +1,7 @@
 package applyer
 
 import (
-	"syscall/js"
+	"reflect"
 
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
@@ -11,63 +11,142 @@ type nativeInputer interface {
 	NativeInput() (*vecty.HTML, string)
 }
 
+type starter interface {
+	Start() error
+}
+
+// rippleEvent is the event StartRipple dispatches to start the ripples set on
+// an element.
+const rippleEvent = "vecty-material-ripple"
+
 func CSSOnly() vecty.Applyer {
 	return vecty.Property("vecty-material-css-only", true)
 }
 
 func IsCSSOnly(h *vecty.HTML) bool {
-	p := findProp("vecty-material-css-only", h)
-	if p.IsNull() {
+	p, ok := property("vecty-material-css-only", h)
+	if !ok || p.Kind() != reflect.Bool {
 		return false
 	}
 	return p.Bool()
 }
 
+// FindID returns the ID of the root element of moc, or an empty string if it
+// has none or it cannot be determined. For a component with a NativeInput
+// method it returns the ID of the native input element. Other components are
+// not rendered, instead the ID is looked up in their Root field, as used by the
+// vecty-material components. A vecty.List has no root element, unless it holds
+// a single item, whose ID is returned. The items of a vecty.KeyedList are not
+// exposed by vecty, so its ID cannot be determined. An Applyer is applied to a
+// scratch element and the ID that element ends up with is returned.
 func FindID(moc vecty.MarkupOrChild) string {
+	if isNil(moc) {
+		return ""
+	}
 	switch t := moc.(type) {
 	case *vecty.MarkupList:
 		return FindID(*t)
 	case nativeInputer:
 		_, id := t.NativeInput()
 		return id
-	case vecty.Applyer:
-		d := elem.Div()
-		t.Apply(d)
-		return FindID(d)
 	case *vecty.HTML:
-		id := findProp("id", t)
-		if id.IsNull() {
+		id, ok := property("id", t)
+		if !ok || id.Kind() != reflect.String {
 			return ""
 		}
 		return id.String()
+	case vecty.List:
+		if len(t) != 1 {
+			return ""
+		}
+		return FindID(t[0])
 	case vecty.Component:
-		if h, ok := t.Render().(*vecty.HTML); ok {
-			return FindID(h)
+		v := reflect.ValueOf(t)
+		if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+			return ""
+		}
+		root := v.Elem().FieldByName("Root")
+		if !root.IsValid() || !root.CanInterface() ||
+			!root.Type().Implements(markupOrChildType) {
+			return ""
 		}
+		m, _ := root.Interface().(vecty.MarkupOrChild)
+		return FindID(m)
+	case vecty.Applyer:
+		d := elem.Div()
+		t.Apply(d)
+		return FindID(d)
 	}
 	return ""
 }
 
+// SetRipple registers r to be started by StartRipple once h is rendered. r is
+// held by an event listener of h, so it goes away along with h, whether or not
+// it was started.
+func SetRipple(h *vecty.HTML, r starter) {
+	(&vecty.EventListener{
+		Name: rippleEvent,
+		Listener: func(e *vecty.Event) {
+			r.Start()
+		},
+	}).Apply(h)
+}
+
+// StartRipple starts the ripples set on h with SetRipple. It does nothing if h
+// is not rendered.
 func StartRipple(h *vecty.HTML) {
-	p := findProp("vecty-material-ripple", h)
-	if p.IsNull() {
+	if h == nil {
+		return
+	}
+	n := h.Node()
+	if !n.Truthy() {
 		return
 	}
-	p.Get("Start").Invoke()
+	view := n.Get("ownerDocument").Get("defaultView")
+	n.Call("dispatchEvent", view.Get("CustomEvent").New(rippleEvent))
+}
+
+// Tag returns the tag name of h, for example "img", or an empty string if h is
+// a text node or nil.
+func Tag(h *vecty.HTML) string {
+	return stringField("tag", h)
 }
 
-func findProp(key string, h *vecty.HTML) js.Value {
-	k := h.Node()
-	if k.IsUndefined() {
-		return js.Null()
+// Text returns the text of h if it was created by vecty.Text.
+func Text(h *vecty.HTML) string {
+	return stringField("text", h)
+}
+
+// property returns the vecty property key of h. It is read from h's markup
+// rather than its DOM node, so it is available before h is rendered.
+func property(key string, h *vecty.HTML) (reflect.Value, bool) {
+	if h == nil {
+		return reflect.Value{}, false
+	}
+	props := reflect.ValueOf(h).Elem().FieldByName("properties")
+	if props.Kind() != reflect.Map || props.IsNil() {
+		return reflect.Value{}, false
 	}
-	k = k.Get("properties")
-	if k.IsUndefined() {
-		return js.Null()
+	p := props.MapIndex(reflect.ValueOf(key))
+	if !p.IsValid() {
+		return reflect.Value{}, false
+	}
+	if p.Kind() == reflect.Interface {
+		if p.IsNil() {
+			return reflect.Value{}, false
+		}
+		p = p.Elem()
+	}
+	return p, true
+}
+
+func stringField(name string, h *vecty.HTML) string {
+	if h == nil {
+		return ""
 	}
-	k = k.Get("$" + key)
-	if k.IsUndefined() {
-		return js.Null()
+	f := reflect.ValueOf(h).Elem().FieldByName(name)
+	if f.Kind() != reflect.String {
+		return ""
 	}
-	return k.Get("v").Get("$val")
+	return f.String()
 }
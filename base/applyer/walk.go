@@ -0,0 +1,127 @@
+package applyer
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/vecty"
+)
+
+var markupOrChildType = reflect.TypeOf((*vecty.MarkupOrChild)(nil)).Elem()
+
+// Walk calls fn for moc and then, depth first, for everything inside it, until
+// fn returns false. It descends into the items of a vecty.List and the exported
+// fields of a component that hold markup or children, such as Root, Input or
+// Items.
+//
+// Components are not rendered, so Walk has no side effects, and it sees the
+// children given to a component rather than the markup the component would
+// render. Components with a NativeInput method are not descended into. vecty
+// does not expose the children of a *vecty.HTML or a vecty.KeyedList, so they
+// are visited but not descended into.
+func Walk(moc vecty.MarkupOrChild, fn func(moc vecty.MarkupOrChild) bool) {
+	w := &walker{fn: fn, seen: map[vecty.Component]bool{}}
+	w.walk(moc)
+}
+
+// FindInput returns the first native input found by Walk in moc, and its ID.
+// The input is either a component with a NativeInput method, or an input,
+// select or textarea element. FindInput returns nil if moc has no input.
+func FindInput(moc vecty.MarkupOrChild) (input vecty.MarkupOrChild, id string) {
+	Walk(moc, func(moc vecty.MarkupOrChild) bool {
+		switch t := moc.(type) {
+		case nativeInputer:
+			input = t
+			_, id = t.NativeInput()
+			return false
+		case *vecty.HTML:
+			switch Tag(t) {
+			case "input", "select", "textarea":
+				input = t
+				id = FindID(t)
+				return false
+			}
+		}
+		return true
+	})
+	return input, id
+}
+
+type walker struct {
+	fn   func(moc vecty.MarkupOrChild) bool
+	seen map[vecty.Component]bool
+}
+
+// walk walks moc and reports whether the walk should continue.
+func (w *walker) walk(moc vecty.MarkupOrChild) bool {
+	if isNil(moc) {
+		return true
+	}
+	if !w.fn(moc) {
+		return false
+	}
+	switch t := moc.(type) {
+	case vecty.List:
+		for _, c := range t {
+			if !w.walk(c) {
+				return false
+			}
+		}
+	case nativeInputer:
+	case vecty.Component:
+		if w.seen[t] {
+			return true
+		}
+		w.seen[t] = true
+		for _, c := range childFields(t) {
+			if !w.walk(c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// childFields returns the non-nil values of the exported, non-embedded fields
+// of c that hold markup or children, in field order. Slices of them are
+// flattened.
+func childFields(c vecty.Component) []vecty.MarkupOrChild {
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	v = v.Elem()
+	var mocs []vecty.MarkupOrChild
+	add := func(f reflect.Value) {
+		if (f.Kind() == reflect.Interface || f.Kind() == reflect.Ptr) &&
+			f.IsNil() {
+			return
+		}
+		mocs = append(mocs, f.Interface().(vecty.MarkupOrChild))
+	}
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if sf.Anonymous || sf.PkgPath != "" {
+			continue
+		}
+		f := v.Field(i)
+		switch {
+		case sf.Type.Implements(markupOrChildType):
+			add(f)
+		case sf.Type.Kind() == reflect.Slice &&
+			sf.Type.Elem().Implements(markupOrChildType):
+			for j := 0; j < f.Len(); j++ {
+				add(f.Index(j))
+			}
+		}
+	}
+	return mocs
+}
+
+// isNil reports whether moc is nil or a nil pointer.
+func isNil(moc vecty.MarkupOrChild) bool {
+	if moc == nil {
+		return true
+	}
+	v := reflect.ValueOf(moc)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
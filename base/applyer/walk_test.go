@@ -0,0 +1,175 @@
+package applyer_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base/applyer"
+)
+
+// wrapper is a component holding children the way the vecty-material
+// components do. Walk and FindID must not render it.
+type wrapper struct {
+	vecty.Core
+	Root  vecty.MarkupOrChild
+	Items []vecty.ComponentOrHTML
+}
+
+func (c *wrapper) Render() vecty.ComponentOrHTML {
+	panic("wrapper rendered")
+}
+
+// input is a component providing a native input.
+type input struct {
+	vecty.Core
+	id string
+}
+
+func (c *input) Render() vecty.ComponentOrHTML {
+	panic("input rendered")
+}
+
+func (c *input) NativeInput() (*vecty.HTML, string) {
+	return elem.Input(vecty.Markup(prop.ID(c.id))), c.id
+}
+
+// describe names moc for the walk order tests.
+func describe(moc vecty.MarkupOrChild) string {
+	switch t := moc.(type) {
+	case vecty.List:
+		return "list"
+	case vecty.KeyedList:
+		return "keyed"
+	case vecty.MarkupList:
+		return "markup"
+	case *wrapper:
+		return "wrapper"
+	case *input:
+		return "input"
+	case *vecty.HTML:
+		if tag := applyer.Tag(t); tag != "" {
+			return tag
+		}
+		return "html"
+	}
+	return "?"
+}
+
+func TestWalk(t *testing.T) {
+	tree := func() vecty.List {
+		return vecty.List{
+			elem.Div(elem.Span()),
+			&wrapper{
+				Root: vecty.Markup(prop.ID("w")),
+				Items: []vecty.ComponentOrHTML{
+					elem.Paragraph(),
+					&input{id: "i"},
+				},
+			},
+			vecty.List{elem.Italic()}.WithKey("k"),
+		}
+	}
+	tests := []struct {
+		name string
+		stop string
+		want []string
+	}{
+		{
+			name: "all",
+			want: []string{"list", "div", "wrapper", "markup", "p", "input",
+				"keyed"},
+		},
+		{
+			name: "stop",
+			stop: "wrapper",
+			want: []string{"list", "div", "wrapper"},
+		},
+	}
+	for _, tt := range tests {
+		var got []string
+		applyer.Walk(tree(), func(moc vecty.MarkupOrChild) bool {
+			got = append(got, describe(moc))
+			return got[len(got)-1] != tt.stop
+		})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Walk() visited %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWalkCycle(t *testing.T) {
+	w := &wrapper{}
+	w.Root = w
+	n := 0
+	applyer.Walk(w, func(moc vecty.MarkupOrChild) bool {
+		n++
+		return true
+	})
+	if n != 2 {
+		t.Errorf("Walk() visited %d times, want 2", n)
+	}
+}
+
+func TestFindInput(t *testing.T) {
+	in := &input{id: "i"}
+	tests := []struct {
+		name      string
+		moc       vecty.MarkupOrChild
+		wantInput string
+		wantID    string
+	}{
+		{"list", vecty.List{elem.Div(), in}, "input", "i"},
+		{"nested", &wrapper{Root: &wrapper{Items: []vecty.ComponentOrHTML{
+			elem.Div(),
+			elem.Select(vecty.Markup(prop.ID("s"))),
+		}}}, "select", "s"},
+		{"in element", elem.Div(elem.Select(vecty.Markup(prop.ID("s")))), "",
+			""},
+		{"none", elem.Div(elem.Span()), "", ""},
+	}
+	for _, tt := range tests {
+		input, id := applyer.FindInput(tt.moc)
+		got := ""
+		if input != nil {
+			got = describe(input)
+		}
+		if got != tt.wantInput || id != tt.wantID {
+			t.Errorf("%s: FindInput() = %s, %q, want %s, %q", tt.name, got,
+				id, tt.wantInput, tt.wantID)
+		}
+	}
+}
+
+func TestFindID(t *testing.T) {
+	tests := []struct {
+		name string
+		moc  vecty.MarkupOrChild
+		want string
+	}{
+		{"nil", nil, ""},
+		{"applyer", prop.ID("a"), "a"},
+		{"html", elem.Input(vecty.Markup(prop.ID("h"))), "h"},
+		{"markup list", vecty.Markup(vecty.Class("m"), prop.ID("m")), "m"},
+		{"keyed", vecty.List{
+			elem.Input(vecty.Markup(prop.ID("k"))),
+		}.WithKey("key"), ""},
+		{"list many", vecty.List{
+			elem.Input(vecty.Markup(prop.ID("a"))),
+			elem.Input(vecty.Markup(prop.ID("b"))),
+		}, ""},
+		{"component", &wrapper{Root: vecty.Markup(prop.ID("c"))}, "c"},
+		{"nested component", &wrapper{
+			Root: &wrapper{Root: vecty.Markup(prop.ID("n"))},
+		}, "n"},
+		{"native input in list", vecty.List{&input{id: "i"}}, "i"},
+		{"nil component", (*wrapper)(nil), ""},
+	}
+	for _, tt := range tests {
+		if got := applyer.FindID(tt.moc); got != tt.want {
+			t.Errorf("%s: FindID() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
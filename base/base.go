@@ -12,6 +12,10 @@ type MDC struct {
 }
 
 func (b *MDC) Mount() {
+	if prerender.on {
+		b.markPrerendered()
+		return
+	}
 	applyer.StartRipple(b.RootElement)
 	switch {
 	case b.Component == nil:
@@ -26,6 +30,9 @@ func (b *MDC) Mount() {
 }
 
 func (b *MDC) Unmount() {
+	if prerender.on {
+		return
+	}
 	if b.Component != nil {
 		err := b.Component.Stop()
 		if err != nil {
@@ -34,17 +41,39 @@ func (b *MDC) Unmount() {
 	}
 }
 
+// Restore sets the property key of b's MDC component to value. Controlled
+// components use it to undo a change the user made that was not accepted by
+// re-rendering the component.
+func (b *MDC) Restore(key string, value interface{}) {
+	if prerender.on || b == nil || b.Component == nil {
+		return
+	}
+	base.Restore(b.Component, base.StateMap{key: value})
+}
+
 // MarkupOnly returns the vecty.MarkupList contained in moc, or nil if none is
-// found. It also returns nil if moc is a vecty.List that contains one or more
-// vecty.ComponentOrHTML.  If nil is returned, it is then safe to assert the
+// found. A single vecty.Applyer is returned as a MarkupList containing it, and
+// an empty vecty.List as an empty MarkupList. It returns nil if moc is any other
+// vecty.ComponentOrHTML, including a vecty.List that contains one or more
+// vecty.ComponentOrHTML. If nil is returned, it is then safe to assert the
 // type of moc as a vecty.ComponentOrHTML.
 func MarkupOnly(moc vecty.MarkupOrChild) *vecty.MarkupList {
-	// TODO: handle vecty.List
 	switch t := moc.(type) {
+	case vecty.List:
+		if len(t) > 0 {
+			return nil
+		}
+		m := vecty.Markup()
+		return &m
 	case vecty.ComponentOrHTML:
 		return nil
 	case vecty.MarkupList:
 		return &t
+	case *vecty.MarkupList:
+		return t
+	case vecty.Applyer:
+		m := vecty.Markup(t)
+		return &m
 	}
 	return nil
 }
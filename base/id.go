@@ -0,0 +1,35 @@
+package base
+
+import "strconv"
+
+// AutoIDPrefix is the prefix of the IDs allocated by AutoID.
+const AutoIDPrefix = "vm-id-"
+
+var autoIDs struct {
+	// next is the number of the next ID allocated outside of prerender mode,
+	// and prerendered that of the next ID allocated while prerendering.
+	next, prerendered int
+}
+
+// AutoID returns *id, after setting it to a newly allocated unique ID if it is
+// empty. Components use it for elements that need an ID to be associated with
+// another element, such as the label of a form field, and that were not given
+// one by the user.
+//
+// Components keep the ID in an unexported field, which vecty preserves between
+// renders, so an element keeps its ID for the life of the component. IDs are
+// allocated in render order starting at AutoIDPrefix+"0". Prerenders use a
+// sequence of their own that restarts with every prerender, so rendering the
+// same component tree always produces the same IDs, and IDs allocated outside
+// of prerender mode are never reused.
+func AutoID(id *string) string {
+	if *id == "" {
+		n := &autoIDs.next
+		if prerender.on {
+			n = &autoIDs.prerendered
+		}
+		*id = AutoIDPrefix + strconv.Itoa(*n)
+		*n++
+	}
+	return *id
+}
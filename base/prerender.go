@@ -0,0 +1,75 @@
+package base
+
+import (
+	"strconv"
+
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/material/base"
+)
+
+const (
+	// PrerenderTypeAttr is the attribute that holds the MDCClassName of a
+	// component's root element in prerendered markup. It is the attribute
+	// read by the AutoInit func of material/base, so prerendered components
+	// can be started with either AutoInit or ssr.Hydrate.
+	PrerenderTypeAttr = base.AutoInitAttr
+
+	// PrerenderIDAttr is the attribute that holds the ID of a component's root
+	// element in prerendered markup. IDs are assigned in mount order, starting
+	// at "vm-0" for every prerender, so rendering the same component tree
+	// always produces the same IDs.
+	PrerenderIDAttr = "data-vecty-material-id"
+)
+
+var prerender struct {
+	on   bool
+	next int
+}
+
+// BeginPrerender puts the package in prerender mode. While in prerender mode
+// MDC.Mount does not start MDC components or ripples, instead it marks their
+// root elements with the PrerenderTypeAttr and PrerenderIDAttr attributes so
+// the components can be started against the markup later. It also restarts the
+// sequence of IDs AutoID allocates while prerendering, which is separate from
+// the one used outside of prerender mode. Call EndPrerender once rendering is
+// done.
+//
+// Prerender mode and the ID sequence are package state shared by every
+// render, so prerenders must not overlap: calls to BeginPrerender and
+// EndPrerender must not be made from concurrent goroutines. The ssr package
+// serializes its renders.
+//
+// Most users will want the ssr package instead of calling BeginPrerender
+// directly.
+func BeginPrerender() {
+	prerender.on = true
+	prerender.next = 0
+	autoIDs.prerendered = 0
+}
+
+// EndPrerender leaves prerender mode.
+func EndPrerender() {
+	prerender.on = false
+}
+
+// Prerendering reports whether the package is in prerender mode.
+func Prerendering() bool {
+	return prerender.on
+}
+
+func (b *MDC) markPrerendered() {
+	switch {
+	case b.Component == nil, b.RootElement == nil:
+		return
+	case applyer.IsCSSOnly(b.RootElement):
+		return
+	}
+	n := b.RootElement.Node()
+	if n.IsUndefined() || n.IsNull() {
+		return
+	}
+	n.Call("setAttribute", PrerenderTypeAttr,
+		b.Component.Component().Type.MDCClassName)
+	n.Call("setAttribute", PrerenderIDAttr, "vm-"+strconv.Itoa(prerender.next))
+	prerender.next++
+}
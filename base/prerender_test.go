@@ -0,0 +1,52 @@
+package base_test
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/vecty-material/material/base"
+	mbase "github.com/vecty-material/material/material/base"
+)
+
+// starter counts the calls made to its Start and Stop methods.
+type starter struct {
+	mdc           mbase.Component
+	starts, stops int
+}
+
+func (c *starter) Component() *mbase.Component   { return c.mdc.Component() }
+func (c *starter) Start(rootElem js.Value) error { c.starts++; return nil }
+func (c *starter) Stop() error                   { c.stops++; return nil }
+
+func TestPrerenderSkipsMount(t *testing.T) {
+	c := &starter{}
+	m := &base.MDC{Component: c}
+	base.BeginPrerender()
+	m.Mount()
+	m.Unmount()
+	base.EndPrerender()
+	if c.starts != 0 || c.stops != 0 {
+		t.Errorf("Start called %d times and Stop %d times while prerendering",
+			c.starts, c.stops)
+	}
+}
+
+func TestPrerenderAutoID(t *testing.T) {
+	var before, first, second, after string
+	base.AutoID(&before)
+	base.BeginPrerender()
+	base.AutoID(&first)
+	base.EndPrerender()
+	base.BeginPrerender()
+	base.AutoID(&second)
+	base.EndPrerender()
+	base.AutoID(&after)
+	if want := base.AutoIDPrefix + "0"; first != want || second != want {
+		t.Errorf("AutoID() = %q, %q while prerendering, want %q", first,
+			second, want)
+	}
+	if after == before {
+		t.Errorf("AutoID() = %q after prerendering, repeats the ID allocated "+
+			"before", after)
+	}
+}
@@ -0,0 +1,196 @@
+// bind provides observable values that vecty-material components can be bound
+// to. A bound component reads the value when it is rendered and sets it when
+// the user changes the component, and every component watching the value is
+// re-rendered when it changes:
+//
+//	dark := bind.NewBool(false)
+//	...
+//	&checkbox.CB{Bind: dark}
+//	&icontoggle.IT{Bind: dark, OnIcon: ..., OffIcon: ...}
+//
+// Components of the app that depend on a value call Watch from Render, and
+// Unwatch from Unmount. Components with a Bind field hold a Binding, synced
+// with the field by Sync from Render and released from Unmount.
+//
+// The methods of a nil value do nothing, and Get returns the zero value. Sync
+// with a nil value releases the previous binding.
+package bind // import "github.com/vecty-material/material/bind"
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/base"
+)
+
+// Bool is an observable bool.
+type Bool struct {
+	watchers
+	value bool
+}
+
+// NewBool returns a new Bool holding v.
+func NewBool(v bool) *Bool {
+	return &Bool{value: v}
+}
+
+// Get returns the value of b.
+func (b *Bool) Get() bool {
+	if b == nil {
+		return false
+	}
+	return b.value
+}
+
+// Set sets the value of b to v, and re-renders the components watching b if
+// it changed.
+func (b *Bool) Set(v bool) {
+	if b == nil || b.value == v {
+		return
+	}
+	b.value = v
+	b.notify()
+}
+
+// Watch makes c re-render when b changes.
+func (b *Bool) Watch(c vecty.Component) {
+	if b != nil {
+		b.watch(c)
+	}
+}
+
+// Unwatch stops c from re-rendering when b changes.
+func (b *Bool) Unwatch(c vecty.Component) {
+	if b != nil {
+		b.unwatch(c)
+	}
+}
+
+// Sync is called from the Render of c, a component whose Bind field is b. If b
+// is set its value is stored in v, and bound makes c watch b in place of the
+// value it was bound to.
+func (b *Bool) Sync(c vecty.Component, bound *Binding, v *bool) {
+	if b == nil {
+		bound.bind(c, nil)
+		return
+	}
+	*v = b.value
+	bound.bind(c, &b.watchers)
+}
+
+// String is an observable string.
+type String struct {
+	watchers
+	value string
+}
+
+// NewString returns a new String holding v.
+func NewString(v string) *String {
+	return &String{value: v}
+}
+
+// Get returns the value of s.
+func (s *String) Get() string {
+	if s == nil {
+		return ""
+	}
+	return s.value
+}
+
+// Set sets the value of s to v, and re-renders the components watching s if
+// it changed.
+func (s *String) Set(v string) {
+	if s == nil || s.value == v {
+		return
+	}
+	s.value = v
+	s.notify()
+}
+
+// Watch makes c re-render when s changes.
+func (s *String) Watch(c vecty.Component) {
+	if s != nil {
+		s.watch(c)
+	}
+}
+
+// Unwatch stops c from re-rendering when s changes.
+func (s *String) Unwatch(c vecty.Component) {
+	if s != nil {
+		s.unwatch(c)
+	}
+}
+
+// Sync is called from the Render of c, a component whose Bind field is s. If s
+// is set its value is stored in v, and bound makes c watch s in place of the
+// value it was bound to.
+func (s *String) Sync(c vecty.Component, bound *Binding, v *string) {
+	if s == nil {
+		bound.bind(c, nil)
+		return
+	}
+	*v = s.value
+	bound.bind(c, &s.watchers)
+}
+
+// Binding records the value a component is bound to by Sync. The zero Binding
+// is bound to no value.
+//
+// Components do not watch values while prerendering, as they are never
+// unmounted.
+type Binding struct {
+	c vecty.Component
+	w *watchers
+}
+
+// Release stops the component from watching the value it is bound to. It is
+// called from the component's Unmount.
+func (b *Binding) Release() {
+	b.bind(b.c, nil)
+}
+
+func (b *Binding) bind(c vecty.Component, w *watchers) {
+	if base.Prerendering() {
+		w = nil
+	}
+	if w == b.w {
+		return
+	}
+	if b.w != nil {
+		b.w.unwatch(b.c)
+	}
+	if w != nil {
+		w.watch(c)
+	}
+	b.c, b.w = c, w
+}
+
+// watchers are the components watching a value, in the order they started
+// watching.
+type watchers struct {
+	list []vecty.Component
+}
+
+func (w *watchers) watch(c vecty.Component) {
+	for _, wc := range w.list {
+		if wc == c {
+			return
+		}
+	}
+	w.list = append(w.list, c)
+}
+
+func (w *watchers) unwatch(c vecty.Component) {
+	for i, wc := range w.list {
+		if wc == c {
+			w.list = append(w.list[:i], w.list[i+1:]...)
+			return
+		}
+	}
+}
+
+func (w *watchers) notify() {
+	// Re-rendering may add or remove watchers.
+	list := append([]vecty.Component(nil), w.list...)
+	for _, c := range list {
+		vecty.Rerender(c)
+	}
+}
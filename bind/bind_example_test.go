@@ -0,0 +1,93 @@
+package bind_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/bind"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func Example() {
+	dark := bind.NewBool(false)
+	cb := &checkbox.CB{Bind: dark, Input: vecty.Markup(prop.ID("dark"))}
+	it := &icontoggle.IT{
+		Bind:    dark,
+		OnIcon:  &icon.I{Name: "brightness_2"},
+		OffIcon: &icon.I{Name: "wb_sunny"},
+	}
+	s, err := materialtest.Mount(elem.Div(cb, it))
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+
+	// Checking the checkbox sets the value, which turns the icontoggle on.
+	materialtest.Click(s.ByID("dark"))
+	fmt.Printf("Value: %v, icontoggle: %v\n", dark.Get(), it.On)
+
+	// Setting the value updates every bound component.
+	dark.Set(false)
+	fmt.Printf("Checkbox: %v, icontoggle: %v\n", cb.Checked, it.On)
+
+	// Output:
+	// Value: true, icontoggle: true
+	// Checkbox: false, icontoggle: false
+}
+
+// lamp is a component with a Bind field.
+type lamp struct {
+	vecty.Core
+	On      bool
+	Bind    *bind.Bool
+	bound   bind.Binding
+	renders int
+}
+
+func (c *lamp) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.On)
+	c.renders++
+	return elem.Div(vecty.Text(fmt.Sprint(c.On)))
+}
+
+func (c *lamp) Unmount() {
+	c.bound.Release()
+}
+
+func ExampleBool_Sync() {
+	on := bind.NewBool(true)
+	l := &lamp{Bind: on}
+	s, err := materialtest.Mount(l)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	fmt.Printf("On: %v, renders: %v\n", l.On, l.renders)
+
+	on.Set(false)
+	fmt.Printf("On: %v, renders: %v\n", l.On, l.renders)
+
+	// Once unmounted the component no longer watches the value.
+	s.Unmount()
+	on.Set(true)
+	fmt.Printf("On: %v, renders: %v\n", l.On, l.renders)
+
+	// Output:
+	// On: true, renders: 1
+	// On: false, renders: 2
+	// On: false, renders: 2
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
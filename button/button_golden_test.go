@@ -0,0 +1,25 @@
+package button_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/button"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &button.B{Label: vecty.Text("Button")}},
+		{"raised-dense-icon", &button.B{Label: vecty.Text("Raised"), Icon: &icon.I{Name: "favorite"}, Raised: true, Dense: true}},
+		{"unelevated", &button.B{Label: vecty.Text("Unelevated"), Unelevated: true}},
+		{"outlined-disabled", &button.B{Label: vecty.Text("Outlined"), Outlined: true, Disabled: true}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -7,25 +7,41 @@ import (
 	"github.com/gopherjs/vecty/prop"
 	"github.com/vecty-material/material/base"
 	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/bind"
 	"github.com/vecty-material/material/material/checkbox"
 )
 
 // CB is a vecty-material checkbox component.
+//
+// By default the user checking the checkbox updates Checked and Indeterminate
+// before OnChange is called. If Controlled is set, they are left unchanged and
+// the checkbox keeps showing them: OnChange reports that the user asked for
+// Checked to be toggled, and the change only happens when the checkbox is
+// rendered with new values. As its fields are vecty props, a parent rendering
+// a new CB sets them on the mounted one.
+//
+// If Bind is set, Checked is read from it on render, and the checked state the
+// user asked for is written to it on change.
 type CB struct {
 	*base.MDC
 	vecty.Core
-	Root          vecty.MarkupOrChild
-	Input         vecty.MarkupOrChild
-	Background    vecty.MarkupOrChild
-	OnChange      func(this *CB, e *vecty.Event)
-	Checked       bool
-	Indeterminate bool
-	Disabled      bool
-	Value         string
+	Root          vecty.MarkupOrChild            `vecty:"prop"`
+	Input         vecty.MarkupOrChild            `vecty:"prop"`
+	Background    vecty.MarkupOrChild            `vecty:"prop"`
+	OnChange      func(this *CB, e *vecty.Event) `vecty:"prop"`
+	Checked       bool                           `vecty:"prop"`
+	Indeterminate bool                           `vecty:"prop"`
+	Disabled      bool                           `vecty:"prop"`
+	Value         string                         `vecty:"prop"`
+	Controlled    bool                           `vecty:"prop"`
+	Bind          *bind.Bool                     `vecty:"prop"`
+	bound         bind.Binding
 }
 
 // Render implements the vecty.Component interface.
 func (c *CB) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Checked)
+
 	rootMarkup := base.MarkupOnly(c.Root)
 	if c.Root != nil && rootMarkup == nil {
 		// User supplied root element.
@@ -67,7 +83,7 @@ func (c *CB) Render() vecty.ComponentOrHTML {
 	return elem.Div(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		input,
 		bg,
@@ -96,16 +112,26 @@ func (c *CB) Apply(h *vecty.HTML) {
 	c.MDC.RootElement = h
 }
 
+// Unmount implements the vecty.Unmounter interface.
+func (c *CB) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
+}
+
 func (c *CB) onChange(e *vecty.Event) {
-	if cb, ok := c.MDC.Component.(*checkbox.CB); ok {
-		c.Checked = cb.Checked
-		c.Indeterminate = cb.Indeterminate
-		c.Disabled = cb.Disabled
-		c.Value = cb.Value
+	input := e.Get("target")
+	if !c.Controlled {
+		c.Checked = input.Get("checked").Bool()
+		c.Indeterminate = input.Get("indeterminate").Bool()
 	}
+	c.Bind.Set(input.Get("checked").Bool())
 	if c.OnChange != nil {
 		c.OnChange(c, e)
 	}
+	if c.Controlled {
+		input.Set("checked", c.Checked)
+		input.Set("indeterminate", c.Indeterminate)
+	}
 }
 
 func (c *CB) NativeInput() (element *vecty.HTML, id string) {
@@ -134,3 +160,16 @@ func (c *CB) NativeInput() (element *vecty.HTML, id string) {
 	id = applyer.FindID(element)
 	return
 }
+
+// SetInputID sets the ID of c's built-in native input element. It does nothing
+// if c has a user supplied input element.
+func (c *CB) SetInputID(id string) {
+	niMarkup := base.MarkupOnly(c.Input)
+	if c.Input != nil && niMarkup == nil {
+		return
+	}
+	c.Input = vecty.Markup(
+		prop.ID(id),
+		vecty.MarkupIf(niMarkup != nil, niMarkup),
+	)
+}
@@ -0,0 +1,26 @@
+package checkbox_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &checkbox.CB{}},
+		{"checked-indeterminate-disabled", &checkbox.CB{Input: vecty.Markup(prop.ID("cb")), Checked: true, Indeterminate: true, Disabled: true}},
+		{"tree", &checkbox.Tree{Selected: []string{"a"}, Nodes: []checkbox.Node{{Label: "All", Children: []checkbox.Node{{Value: "a", Label: "A"}, {Value: "b", Label: "B"}}}}}},
+		{"css-only-value", &checkbox.CB{Root: vecty.Markup(applyer.CSSOnly(), vecty.Class("demo")), Value: "yes"}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
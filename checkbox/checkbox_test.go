@@ -0,0 +1,126 @@
+package checkbox_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/materialtest"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	checked, accept := false, false
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &checkbox.CB{
+			Input:      vecty.Markup(prop.ID("cb")),
+			Checked:    checked,
+			Controlled: true,
+			OnChange: func(this *checkbox.CB, e *vecty.Event) {
+				if accept {
+					checked = e.Get("target").Get("checked").Bool()
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("cb")
+
+	// A rejected change is undone.
+	materialtest.Click(input)
+	materialtest.AssertProp(t, input, "checked", false)
+
+	accept = true
+	materialtest.Click(input)
+	materialtest.AssertProp(t, input, "checked", true)
+
+	// Rendering the checkbox with a new value changes it, and later rejected
+	// changes are undone to that value.
+	checked = false
+	vecty.Rerender(a)
+	materialtest.AssertProp(t, input, "checked", false)
+	accept = false
+	materialtest.Click(input)
+	materialtest.AssertProp(t, input, "checked", false)
+}
+
+func TestTree(t *testing.T) {
+	var changes []string
+	tr := &checkbox.Tree{
+		Nodes: []checkbox.Node{
+			{Label: "Fruit", Children: []checkbox.Node{
+				{Value: "apple", Label: "Apple"},
+				{Value: "pear", Label: "Pear"},
+			}},
+			{Value: "kale", Label: "Kale"},
+		},
+		OnChange: func(selected []string) {
+			changes = append(changes, fmt.Sprint(selected))
+		},
+	}
+	s, err := materialtest.Mount(tr)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	fruit := s.ByLabelText("Fruit")
+	assert := func(selected string, checked, indeterminate bool) {
+		t.Helper()
+		if got := fmt.Sprint(tr.Selected); got != selected {
+			t.Errorf("Selected = %s, want %s", got, selected)
+		}
+		materialtest.AssertProp(t, fruit, "checked", checked)
+		materialtest.AssertProp(t, fruit, "indeterminate", indeterminate)
+	}
+
+	materialtest.Click(s.ByLabelText("Apple"))
+	assert("[apple]", false, true)
+	materialtest.AssertProp(t, s.ByLabelText("Apple"), "checked", true)
+
+	// Toggling the parent checks the remaining leaves.
+	materialtest.Click(fruit)
+	assert("[apple pear]", true, false)
+	materialtest.AssertProp(t, s.ByLabelText("Pear"), "checked", true)
+
+	materialtest.Click(s.ByLabelText("Kale"))
+	assert("[apple pear kale]", true, false)
+
+	// And unchecks all of them once they are all checked.
+	materialtest.Click(fruit)
+	assert("[kale]", false, false)
+	materialtest.AssertProp(t, s.ByLabelText("Apple"), "checked", false)
+
+	want := "[[apple] [apple pear] [apple pear kale] [kale]]"
+	if got := fmt.Sprint(changes); got != want {
+		t.Errorf("OnChange values = %s, want %s", got, want)
+	}
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
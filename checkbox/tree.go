@@ -0,0 +1,156 @@
+package checkbox
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/formfield"
+)
+
+// Node is a node of a Tree. A node without Children is a leaf, whose Value is
+// reported when it is checked. The checkbox of a node with Children reflects
+// the leaves below it.
+type Node struct {
+	Value    string
+	Label    string
+	Disabled bool
+	Children []Node
+}
+
+// Tree is a vecty-material checkbox tree component. It renders a labelled
+// checkbox for each node of Nodes, with the children of a node indented below
+// it. A parent is checked when all leaves below it are checked, indeterminate
+// when some are, and toggling it checks or unchecks all of them.
+type Tree struct {
+	vecty.Core
+	Root  vecty.MarkupOrChild `vecty:"prop"`
+	Nodes []Node              `vecty:"prop"`
+
+	// Selected holds the values of the checked leaves, in tree order.
+	Selected []string `vecty:"prop"`
+
+	// OnChange is called with the new Selected when the user toggles a node.
+	OnChange func(selected []string) `vecty:"prop"`
+
+	// Controlled leaves Selected unchanged when the user toggles a node, see
+	// CB. OnChange then reports the selection the user asked for.
+	Controlled bool `vecty:"prop"`
+}
+
+// Render implements the vecty.Component interface.
+func (c *Tree) Render() vecty.ComponentOrHTML {
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	return elem.Div(
+		vecty.Markup(
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+		),
+		c.nodes(c.Nodes, c.selected()),
+	)
+}
+
+func (c *Tree) nodes(nodes []Node, selected map[string]bool) vecty.List {
+	l := make(vecty.List, len(nodes))
+	for i := range nodes {
+		l[i] = c.node(&nodes[i], selected)
+	}
+	return l
+}
+
+func (c *Tree) node(n *Node, selected map[string]bool) vecty.ComponentOrHTML {
+	checked, indeterminate := state(n, selected)
+	ff := &formfield.FF{
+		Label: n.Label,
+		Input: &CB{
+			Checked:       checked,
+			Indeterminate: indeterminate,
+			Disabled:      n.Disabled || len(leaves(n, false)) == 0,
+			// The tree, not the checkbox, holds the state.
+			Controlled: true,
+			OnChange: func(this *CB, e *vecty.Event) {
+				c.toggle(n)
+			},
+		},
+	}
+	if len(n.Children) == 0 {
+		return ff
+	}
+	return elem.Div(
+		ff,
+		elem.Div(
+			vecty.Markup(
+				vecty.Style("margin-left", "24px"),
+			),
+			c.nodes(n.Children, selected),
+		),
+	)
+}
+
+// toggle checks the enabled leaves of n, or unchecks them if all of them are
+// checked.
+func (c *Tree) toggle(n *Node) {
+	selected := c.selected()
+	enabled := leaves(n, false)
+	check := false
+	for _, l := range enabled {
+		check = check || !selected[l.Value]
+	}
+	for _, l := range enabled {
+		selected[l.Value] = check
+	}
+	var values []string
+	for i := range c.Nodes {
+		for _, l := range leaves(&c.Nodes[i], true) {
+			if selected[l.Value] {
+				values = append(values, l.Value)
+			}
+		}
+	}
+	if !c.Controlled {
+		c.Selected = values
+		vecty.Rerender(c)
+	}
+	if c.OnChange != nil {
+		c.OnChange(values)
+	}
+}
+
+func (c *Tree) selected() map[string]bool {
+	s := make(map[string]bool, len(c.Selected))
+	for _, v := range c.Selected {
+		s[v] = true
+	}
+	return s
+}
+
+// state returns whether n is checked or indeterminate.
+func state(n *Node, selected map[string]bool) (checked, indeterminate bool) {
+	all := leaves(n, true)
+	count := 0
+	for _, l := range all {
+		if selected[l.Value] {
+			count++
+		}
+	}
+	return len(all) > 0 && count == len(all), count > 0 && count < len(all)
+}
+
+// leaves returns the leaves of n, or n itself if it is a leaf. Disabled nodes
+// and the leaves below them are only included if disabled is true.
+func leaves(n *Node, disabled bool) []*Node {
+	if n.Disabled && !disabled {
+		return nil
+	}
+	if len(n.Children) == 0 {
+		return []*Node{n}
+	}
+	var l []*Node
+	for i := range n.Children {
+		l = append(l, leaves(&n.Children[i], disabled)...)
+	}
+	return l
+}
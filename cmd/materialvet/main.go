@@ -0,0 +1,18 @@
+// materialvet runs the vecty-material analyzers of package vet as a go vet
+// tool:
+//
+//	go vet -vettool=$(which materialvet) ./...
+//
+// Building materialvet needs Go 1.22 or newer, as required by the version of
+// golang.org/x/tools pinned in Gopkg.toml.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/unitchecker"
+
+	"github.com/vecty-material/material/vet"
+)
+
+func main() {
+	unitchecker.Main(vet.Analyzers...)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"text/template"
+)
+
+const importBase = "github.com/vecty-material/material/material"
+
+var funcs = template.FuncMap{
+	"comment": comment,
+}
+
+var componentTmpl = template.Must(template.New("component").Funcs(funcs).Parse(
+	`// {{.Package}} implements a material {{.Description}} component.
+{{- if .DocURL}}
+//
+// See: {{.DocURL}}
+{{- end}}
+package {{.Package}} // import "` + importBase + `/{{.Package}}"
+
+import (
+	"syscall/js"
+{{if or .Methods .Events}}
+	"github.com/vecty-material/material/gojs"
+{{- end}}
+	"github.com/vecty-material/material/material/base"
+)
+
+// {{.Type}} is a material {{.Description}} component.
+type {{.Type}} struct {
+	mdc *base.Component
+{{range .Properties}}
+{{comment "\t" .Doc}}	{{.Name}} {{.Type}} {{.Tag}}
+{{end -}}
+}
+
+// New returns a new component.
+func New() *{{.Type}} {
+	c := &{{.Type}}{}
+	c.Component()
+	return c
+}
+
+func init() {
+	base.Register("{{.MDCClassName}}", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
+// Start initializes the component with an existing HTMLElement, rootElem. Start
+// should only be used on a newly created component, or after calling Stop.
+func (c *{{.Type}}) Start(rootElem js.Value) error {
+{{- if .HasAfterStart}}
+	backup := base.Backup(c)
+	err := base.Start(c, rootElem)
+	if err != nil {
+		return err
+	}
+	err = c.afterStart()
+	if err != nil {
+		c.Stop()
+		return err
+	}
+	base.Restore(c, backup)
+	return nil
+{{- else}}
+	return base.Start(c, rootElem)
+{{- end}}
+}
+
+// Stop removes the component's association with its HTMLElement and cleans up
+// event listeners, etc.
+func (c *{{.Type}}) Stop() error {
+	return base.Stop(c)
+}
+
+// Component returns the component's underlying base.Component.
+func (c *{{.Type}}) Component() *base.Component {
+	switch {
+	case c.mdc == nil:
+		c.mdc = &base.Component{
+			Type: base.ComponentType{
+				MDCClassName:     "{{.MDCClassName}}",
+				MDCCamelCaseName: "{{.MDCCamelCaseName}}",
+			},
+		}
+		fallthrough
+	case c.mdc.Value.IsNull():
+		c.mdc.Component().SetState(c.StateMap())
+	}
+	return c.mdc.Component()
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *{{.Type}}) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+{{range .Methods}}
+// {{.Name}}{{if .Doc}} {{.Doc}}{{else}} calls the MDC component's {{.JS}}() method.{{end}}
+func (c *{{$.Type}}) {{.Name}}() (err error) {
+	defer gojs.CatchException(&err)
+	c.Component().Call("{{.JS}}")
+	return err
+}
+{{end}}
+{{- range .Events}}
+// On{{.Name}}{{if .Doc}} {{.Doc}}{{else}} registers f to be called for "{{.JS}}" events.{{end}}
+// Handlers must be registered after calling Start. The returned remove func
+// unregisters f and releases the js.Func wrapping it.
+func (c *{{$.Type}}) On{{.Name}}(f func(e js.Value)) (remove func(), err error) {
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		f(args[0])
+		return nil
+	})
+	defer func() {
+		if err != nil {
+			fn.Release()
+		}
+	}()
+	defer gojs.CatchException(&err)
+	c.Component().Call("listen", "{{.JS}}", fn)
+	return func() {
+		c.Component().Call("unlisten", "{{.JS}}", fn)
+		fn.Release()
+	}, nil
+}
+{{end}}`))
+
+var afterStartTmpl = template.Must(template.New("afterStart").Funcs(funcs).Parse(
+	`package {{.Package}}
+
+import (
+	"github.com/vecty-material/material/material/base"
+)
+
+// afterStart defines missing getters and setters for {{.MDCClassName}}
+// properties, so that we can use our struct fields as one would normally
+// expect.
+func (c *{{.Type}}) afterStart() (err error) {
+	o := c.Component()
+{{- range .Properties}}
+{{- if or .FoundationGetter .FoundationSetter}}
+	err = base.DefineSetGet(c, "{{.JS}}",
+{{- if .FoundationSetter}}
+		func(v interface{}) {
+			o.Get("foundation_").Call("{{.FoundationSetter}}", v)
+		},
+{{- else}}
+		nil,
+{{- end}}
+{{- if .FoundationGetter}}
+		func() interface{} {
+			return o.Get("foundation_").Get("{{.FoundationGetter}}").{{.JSGetter}}()
+		},
+{{- else}}
+		nil,
+{{- end}}
+	)
+	if err != nil {
+		return err
+	}
+{{- end}}
+{{- end}}
+	return nil
+}
+`))
+
+var exampleTmpl = template.Must(template.New("example").Funcs(funcs).Parse(
+	`package {{.Package}}_test
+
+import (
+	"fmt"
+	"log"
+
+	"syscall/js"
+
+	"` + importBase + `/internal/mdctest"
+	"` + importBase + `/{{.Package}}"
+)
+
+func Example() {
+	// Create a new instance of a material {{.Description}} component.
+	c := {{.Package}}.New()
+	printName(c)
+	printState(c)
+
+	// Set up a DOM HTMLElement suitable for a {{.Description}}.
+	js.Global().Get("document").Get("body").Set("innerHTML",
+		mdctest.HTML(c.Component().Type.MDCClassName))
+	rootElem := js.Global().Get("document").Get("body").Get("firstElementChild")
+
+	// Start the component, which associates it with an HTMLElement.
+	err := c.Start(rootElem)
+	if err != nil {
+		log.Fatalf("Unable to start component %s: %v\n",
+			c.Component().Type, err)
+	}
+	printName(c)
+
+	// TODO: Change properties and print their Go and JS values.
+
+	err = c.Stop()
+	if err != nil {
+		log.Fatalf("Unable to stop component %s: %v\n",
+			c.Component().Type, err)
+	}
+	printName(c)
+
+	// Output:
+	// {{.MDCClassName}}
+	//
+	// [Go]{{range $i, $p := .Properties}}{{if $i}},{{end}} {{$p.Name}}: {{$p.ZeroValue}}{{end}}
+	// {{.MDCClassName}}
+	// {{.MDCClassName}}
+}
+
+func printName(c *{{.Package}}.{{.Type}}) {
+	fmt.Printf("%s\n", c.Component().Type)
+}
+
+func printState(c *{{.Package}}.{{.Type}}) {
+	fmt.Println()
+	fmt.Printf("[Go]{{range $i, $p := .Properties}}{{if $i}},{{end}} {{$p.Name}}: %v{{end}}\n"{{range .Properties}},
+		c.{{.Name}}{{end}})
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := mdctest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
+`))
+
+// File is a generated source file.
+type File struct {
+	Name string
+	Src  []byte
+}
+
+// Generate returns the gofmt'd source files of the binding package described
+// by s.
+func Generate(s *Spec) ([]File, error) {
+	type job struct {
+		name string
+		tmpl *template.Template
+	}
+	jobs := []job{
+		{s.Package + ".go", componentTmpl},
+	}
+	if s.HasAfterStart() {
+		jobs = append(jobs, job{"after_start.go", afterStartTmpl})
+	}
+	jobs = append(jobs, job{s.Package + "_example_test.go", exampleTmpl})
+
+	var files []File
+	for _, j := range jobs {
+		buf := &bytes.Buffer{}
+		if err := j.tmpl.Execute(buf, s); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v\n%s", j.name, err, buf.Bytes())
+		}
+		files = append(files, File{Name: j.name, Src: src})
+	}
+	return files, nil
+}
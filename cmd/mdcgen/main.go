@@ -0,0 +1,97 @@
+// mdcgen generates material component bindings from a declarative spec.
+//
+// Every package in github.com/vecty-material/material/material follows the same
+// New/Start/Stop/Component/StateMap template. mdcgen reads a JSON spec that
+// describes an MDC component and writes a package following that template,
+// including afterStart accessors defined with base.DefineSetGet and an Example
+// test skeleton that uses mdctest.
+//
+// Usage:
+//
+//	mdcgen -spec slider.json -out material/
+//
+// A spec looks like:
+//
+//	{
+//	  "package": "linearprogress",
+//	  "type": "LP",
+//	  "description": "linearprogress",
+//	  "docURL": "https://material.io/components/web/catalog/linear-progress/",
+//	  "mdcClassName": "MDCLinearProgress",
+//	  "mdcCamelCaseName": "linearProgress",
+//	  "properties": [
+//	    {"name": "Determinate", "js": "determinate", "type": "bool",
+//	     "foundationGetter": "determinate_",
+//	     "foundationSetter": "setDeterminate"}
+//	  ],
+//	  "methods": [
+//	    {"name": "Open", "js": "open", "doc": "opens the component."}
+//	  ],
+//	  "events": []
+//	}
+//
+// The generated package is written to a directory named after the spec's
+// package inside the -out directory. Existing files are only replaced when -f
+// is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	var (
+		specPath = flag.String("spec", "", "path to the component spec (JSON)")
+		outDir   = flag.String("out", ".", "directory the package is created in")
+		force    = flag.Bool("f", false, "overwrite existing files")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("mdcgen: ")
+
+	if *specPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*specPath, *outDir, *force); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath, outDir string, force bool) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s, err := ReadSpec(f)
+	if err != nil {
+		return fmt.Errorf("%s: %v", specPath, err)
+	}
+	files, err := Generate(s)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(outDir, s.Package)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, gf := range files {
+		path := filepath.Join(dir, gf.Name)
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists, use -f to overwrite", path)
+		}
+		if err := ioutil.WriteFile(path, gf.Src, 0644); err != nil {
+			return err
+		}
+		fmt.Println(path)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	f, err := os.Open("testdata/slider.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s, err := ReadSpec(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]string{
+		"slider.go": {
+			`package slider // import "github.com/vecty-material/material/material/slider"`,
+			"Value float64 `js:\"value\"`",
+			`MDCClassName:     "MDCSlider",`,
+			`base.Register("MDCSlider", func() base.ComponentStartStopper {`,
+			"func (c *S) Layout() (err error) {",
+			"func (c *S) OnChange(f func(e js.Value)) (remove func(), err error) {",
+			`c.Component().Call("listen", "MDCSlider:change", fn)`,
+			`c.Component().Call("unlisten", "MDCSlider:change", fn)`,
+			"fn.Release()",
+			"err = c.afterStart()",
+		},
+		"after_start.go": {
+			`o.Get("foundation_").Call("setStep", v)`,
+			`return o.Get("foundation_").Get("step_").Float()`,
+		},
+		"slider_example_test.go": {
+			"// [Go] Value: 0, Disabled: false, Step: 0",
+		},
+	}
+	if len(files) != len(want) {
+		t.Fatalf("got %d files, want %d", len(files), len(want))
+	}
+	for _, gf := range files {
+		snippets, ok := want[gf.Name]
+		if !ok {
+			t.Errorf("unexpected file %s", gf.Name)
+			continue
+		}
+		for _, snippet := range snippets {
+			if !strings.Contains(string(gf.Src), snippet) {
+				t.Errorf("%s does not contain %q:\n%s", gf.Name, snippet,
+					gf.Src)
+			}
+		}
+	}
+}
+
+func TestReadSpecInvalid(t *testing.T) {
+	for _, spec := range []string{
+		`{}`,
+		`{"package": "../x", "type": "X", "mdcClassName": "MDCX", "mdcCamelCaseName": "x"}`,
+		`{"package": "func", "type": "X", "mdcClassName": "MDCX", "mdcCamelCaseName": "x"}`,
+		`{"package": "x", "type": "x", "mdcClassName": "MDCX", "mdcCamelCaseName": "x"}`,
+		`{"package": "x", "type": "X", "mdcClassName": "MDCX"}`,
+		`{"package": "x", "type": "X", "mdcClassName": "MDCX", "mdcCamelCaseName": "x",
+		  "properties": [{"name": "A", "js": "a", "type": "chan int"}]}`,
+		`{"package": "x", "type": "X", "mdcClassName": "MDCX", "mdcCamelCaseName": "x",
+		  "properties": [{"name": "A", "js": "a", "type": "bool"}],
+		  "methods": [{"name": "A", "js": "a"}]}`,
+	} {
+		if _, err := ReadSpec(strings.NewReader(spec)); err == nil {
+			t.Errorf("ReadSpec(%s) succeeded, want error", spec)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Spec is a declarative description of an MDC component binding.
+type Spec struct {
+	// Package is the name of the generated Go package, for example "slider".
+	Package string `json:"package"`
+
+	// Type is the name of the generated Go type, for example "S".
+	Type string `json:"type"`
+
+	// Description is used in the package and type doc comments, for example
+	// "slider". Defaults to Package.
+	Description string `json:"description"`
+
+	// DocURL is the MDC catalog page of the component.
+	DocURL string `json:"docURL"`
+
+	// MDCClassName is the name of the MDC class, for example "MDCSlider".
+	MDCClassName string `json:"mdcClassName"`
+
+	// MDCCamelCaseName is the name of the object holding the MDC class in the
+	// all-in-one MDC distribution, for example "slider".
+	MDCCamelCaseName string `json:"mdcCamelCaseName"`
+
+	Properties []Property `json:"properties"`
+	Methods    []Method   `json:"methods"`
+	Events     []Event    `json:"events"`
+}
+
+// Property is a field of the component that is bound to a property of the MDC
+// instance.
+type Property struct {
+	// Name is the Go field name, for example "Value".
+	Name string `json:"name"`
+
+	// JS is the MDC property name, for example "value".
+	JS string `json:"js"`
+
+	// Type is the Go type of the field. One of bool, string, int or float64.
+	Type string `json:"type"`
+
+	// Doc is the field's doc comment, without the leading "//".
+	Doc string `json:"doc"`
+
+	// FoundationGetter and FoundationSetter name a foundation_ property and
+	// method that back the property when the MDC class does not provide a
+	// getter or setter of its own, for example "determinate_" and
+	// "setDeterminate". If either is set, the accessor is defined with
+	// base.DefineSetGet in afterStart.
+	FoundationGetter string `json:"foundationGetter"`
+	FoundationSetter string `json:"foundationSetter"`
+
+	// WriteOnly marks the property `mdc:"writeonly"`.
+	WriteOnly bool `json:"writeOnly"`
+}
+
+// Method is a method of the MDC instance that is exposed on the Go type.
+type Method struct {
+	// Name is the Go method name, for example "Layout".
+	Name string `json:"name"`
+
+	// JS is the MDC method name, for example "layout".
+	JS string `json:"js"`
+
+	// Doc is the method's doc comment, without the leading "//" and the
+	// method name.
+	Doc string `json:"doc"`
+}
+
+// Event is a custom event emitted by the MDC component.
+type Event struct {
+	// Name is used to build the Go handler method name, for example "Change"
+	// for OnChange.
+	Name string `json:"name"`
+
+	// JS is the event type, for example "MDCSlider:change".
+	JS string `json:"js"`
+
+	// Doc is the handler's doc comment, without the leading "//" and the
+	// method name.
+	Doc string `json:"doc"`
+}
+
+var goTypes = map[string]string{
+	"bool":    "false",
+	"string":  "",
+	"int":     "0",
+	"float64": "0",
+}
+
+// ReadSpec decodes a JSON Spec from r and validates it.
+func ReadSpec(r io.Reader) (*Spec, error) {
+	s := &Spec{}
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+	if err := d.Decode(s); err != nil {
+		return nil, err
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Spec) validate() error {
+	switch {
+	case !token.IsIdentifier(s.Package):
+		// Package is also used as the output directory name.
+		return fmt.Errorf("spec: package %q is not a valid identifier",
+			s.Package)
+	case !token.IsIdentifier(s.Type) || !isExported(s.Type):
+		return fmt.Errorf("spec: type %q is not an exported identifier",
+			s.Type)
+	case s.MDCClassName == "", s.MDCCamelCaseName == "":
+		return errors.New("spec: mdcClassName and mdcCamelCaseName are required")
+	}
+	if s.Description == "" {
+		s.Description = s.Package
+	}
+	names := map[string]bool{}
+	for _, p := range s.Properties {
+		if !token.IsIdentifier(p.Name) || !isExported(p.Name) || p.JS == "" {
+			return fmt.Errorf("spec: invalid property %q", p.Name)
+		}
+		if _, ok := goTypes[p.Type]; !ok {
+			return fmt.Errorf("spec: property %s has unsupported type %q",
+				p.Name, p.Type)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("spec: duplicate name %q", p.Name)
+		}
+		names[p.Name] = true
+	}
+	for _, m := range s.Methods {
+		if !token.IsIdentifier(m.Name) || !isExported(m.Name) || m.JS == "" {
+			return fmt.Errorf("spec: invalid method %q", m.Name)
+		}
+		if names[m.Name] {
+			return fmt.Errorf("spec: duplicate name %q", m.Name)
+		}
+		names[m.Name] = true
+	}
+	for _, e := range s.Events {
+		if !token.IsIdentifier(e.Name) || !isExported(e.Name) || e.JS == "" {
+			return fmt.Errorf("spec: invalid event %q", e.Name)
+		}
+		if names["On"+e.Name] {
+			return fmt.Errorf("spec: duplicate name %q", "On"+e.Name)
+		}
+		names["On"+e.Name] = true
+	}
+	return nil
+}
+
+// HasAfterStart reports whether any property needs an accessor defined after
+// the MDC instance is created.
+func (s *Spec) HasAfterStart() bool {
+	for _, p := range s.Properties {
+		if p.FoundationGetter != "" || p.FoundationSetter != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ZeroValue returns the value that fmt's %v verb prints for the zero value of
+// p's type.
+func (p Property) ZeroValue() string {
+	return goTypes[p.Type]
+}
+
+// Tag returns the struct tag of the field generated for p.
+func (p Property) Tag() string {
+	if p.WriteOnly {
+		return fmt.Sprintf("`js:%q mdc:\"writeonly\"`", p.JS)
+	}
+	return fmt.Sprintf("`js:%q`", p.JS)
+}
+
+// JSGetter returns the js.Value method used to read p from a foundation.
+func (p Property) JSGetter() string {
+	switch p.Type {
+	case "bool":
+		return "Bool"
+	case "int":
+		return "Int"
+	case "float64":
+		return "Float"
+	}
+	return "String"
+}
+
+func isExported(s string) bool {
+	return s != "" && unicode.IsUpper([]rune(s)[0])
+}
+
+// comment formats doc as a Go comment indented by indent.
+func comment(indent, doc string) string {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return ""
+	}
+	var lines []string
+	for _, l := range strings.Split(doc, "\n") {
+		lines = append(lines, indent+"// "+strings.TrimSpace(l))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -104,7 +104,9 @@ func (c *MenuDemoView) Render() vecty.ComponentOrHTML {
 			ulItem.Selected = true
 		}
 		sItem := c.menuItemsExtraLarge[index].(*ul.Item)
-		lsiStatus.name = js.InternalObject(sItem.Primary).Get("text").String()
+		if h, ok := sItem.Primary.(*vecty.HTML); ok {
+			lsiStatus.name = applyer.Text(h)
+		}
 		lsiStatus.index = index
 		vecty.Rerender(lsiStatus)
 		vecty.Rerender(demoM)
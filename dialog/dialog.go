@@ -8,29 +8,49 @@ import (
 	"github.com/gopherjs/vecty/prop"
 	"github.com/vecty-material/material/base"
 	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/bind"
 	"github.com/vecty-material/material/button"
 	"github.com/vecty-material/material/material/dialog"
 )
 
 // D is a material dialog component.
+//
+// By default the dialog closing on accept or cancel sets Open to false before
+// OnAccept or OnCancel is called. If Controlled is set, Open is left unchanged
+// and the dialog keeps showing it: the callbacks report that the user asked
+// for the dialog to close, and it only closes when it is rendered with Open
+// set to false.
+//
+// If Bind is set, Open is read from it on render, and false is written to it
+// when the dialog closes.
+//
+// The dialog's header and body are referenced by aria-labelledby and
+// aria-describedby through IDs derived from the ID set in Root, or from one
+// allocated with base.AutoID if Root sets none.
 type D struct {
 	*base.MDC
 	vecty.Core
-	Root       vecty.MarkupOrChild
-	Header     string
-	Body       vecty.ComponentOrHTML
-	Role       string
-	Open       bool
-	NoBackdrop bool
-	Scrollable bool
-	AcceptBtn  *button.B
-	CancelBtn  *button.B
-	OnAccept   func(this *D, e *vecty.Event)
-	OnCancel   func(this *D, e *vecty.Event)
+	Root       vecty.MarkupOrChild           `vecty:"prop"`
+	Header     string                        `vecty:"prop"`
+	Body       vecty.ComponentOrHTML         `vecty:"prop"`
+	Role       string                        `vecty:"prop"`
+	Open       bool                          `vecty:"prop"`
+	NoBackdrop bool                          `vecty:"prop"`
+	Scrollable bool                          `vecty:"prop"`
+	AcceptBtn  *button.B                     `vecty:"prop"`
+	CancelBtn  *button.B                     `vecty:"prop"`
+	OnAccept   func(this *D, e *vecty.Event) `vecty:"prop"`
+	OnCancel   func(this *D, e *vecty.Event) `vecty:"prop"`
+	Controlled bool                          `vecty:"prop"`
+	Bind       *bind.Bool                    `vecty:"prop"`
+	bound      bind.Binding
+	autoID     string
 }
 
 // Render implements the vecty.Component interface.
 func (c *D) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Open)
+
 	rootMarkup := base.MarkupOnly(c.Root)
 	if c.Root != nil && rootMarkup == nil {
 		// User supplied root element.
@@ -69,17 +89,15 @@ func (c *D) Render() vecty.ComponentOrHTML {
 		)
 	}
 
-	h := elem.Aside(
-		vecty.Markup(
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
-		),
-	)
+	id := c.id(rootMarkup)
 
 	// Built-in root element.
 	return elem.Aside(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			vecty.Attribute("aria-labelledby", id+"-label"),
+			vecty.Attribute("aria-describedby", id+"-description"),
 		),
 		elem.Div(
 			vecty.Markup(
@@ -92,15 +110,14 @@ func (c *D) Render() vecty.ComponentOrHTML {
 				elem.Heading2(
 					vecty.Markup(
 						vecty.Class("mdc-dialog__header__title"),
-						vecty.MarkupIf(c.labelID(h) != "",
-							prop.ID(c.labelID(h))),
+						prop.ID(id+"-label"),
 					),
 					vecty.Text(c.Header),
 				),
 			),
 			elem.Section(
 				vecty.Markup(
-					prop.ID(c.descriptionID(h)),
+					prop.ID(id+"-description"),
 					vecty.Class("mdc-dialog__body"),
 					vecty.MarkupIf(c.Scrollable,
 						vecty.Class("mdc-dialog__body--scrollable")),
@@ -133,63 +150,55 @@ func (c *D) Apply(h *vecty.HTML) {
 	case c.MDC.Component == nil:
 		c.MDC.Component = dialog.New()
 	}
-	c.MDC.Component.(*dialog.D).Open = c.Open
+	if d, ok := c.MDC.Component.(*dialog.D); ok {
+		d.Open = c.Open
+	}
 	vecty.Markup(
 		vecty.Class("mdc-dialog"),
 		vecty.MarkupIf(c.Role == "", vecty.Attribute("role", "dialog")),
 		vecty.MarkupIf(c.Role != "", vecty.Attribute("role", c.Role)),
 		vecty.MarkupIf(c.Open, vecty.Class("mdc-dialog--open")),
 		vecty.MarkupIf(!c.Open, vecty.Attribute("aria-hidden", "true")),
-		c.ariaLabelledBy(h),
-		c.ariaDescribedBy(h),
 	).Apply(h)
 	c.MDC.RootElement = h
 }
 
-func (c *D) labelID(h *vecty.HTML) string {
-	id := applyer.FindID(h)
-	if id == "" {
-		return ""
-	}
-	return id + "-label"
+// id returns the ID the dialog's label and description IDs are derived from.
+// It is the ID set in the dialog's root markup, or one allocated by
+// base.AutoID if there is none.
+func (c *D) id(rootMarkup *vecty.MarkupList) string {
+	if rootMarkup != nil {
+		if id := applyer.FindID(rootMarkup); id != "" {
+			return id
+		}
+	}
+	return base.AutoID(&c.autoID)
 }
 
-func (c *D) ariaLabelledBy(h *vecty.HTML) vecty.Applyer {
-	if c.labelID(h) == "" {
-		return nil
-	}
-	return vecty.Attribute("aria-labelledby", c.labelID(h))
+// Unmount implements the vecty.Unmounter interface.
+func (c *D) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
 }
 
-func (c *D) descriptionID(h *vecty.HTML) string {
-	id := applyer.FindID(h)
-	if id == "" {
-		return ""
-	}
-	return id + "-description"
+func (c *D) onCancel(e *vecty.Event) {
+	c.closed(e, c.OnCancel)
 }
 
-func (c *D) ariaDescribedBy(h *vecty.HTML) vecty.Applyer {
-	if c.descriptionID(h) == "" {
-		return nil
-	}
-	return vecty.Attribute("aria-describedby", c.descriptionID(h))
+func (c *D) onAccept(e *vecty.Event) {
+	c.closed(e, c.OnAccept)
 }
 
-func (c *D) onCancel(e *vecty.Event) {
-	if d, ok := c.MDC.Component.(*dialog.D); ok {
-		c.Open = d.Open
-	}
-	if c.OnCancel != nil {
-		c.OnCancel(c, e)
+// closed handles the dialog being closed by one of its buttons, calling fn.
+func (c *D) closed(e *vecty.Event, fn func(this *D, e *vecty.Event)) {
+	if !c.Controlled {
+		c.Open = false
 	}
-}
-
-func (c *D) onAccept(e *vecty.Event) {
-	if d, ok := c.MDC.Component.(*dialog.D); ok {
-		c.Open = d.Open
+	c.Bind.Set(false)
+	if fn != nil {
+		fn(c, e)
 	}
-	if c.OnAccept != nil {
-		c.OnAccept(c, e)
+	if c.Controlled && c.Open {
+		c.MDC.Restore("open", true)
 	}
 }
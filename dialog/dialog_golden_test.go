@@ -0,0 +1,23 @@
+package dialog_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/dialog"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &dialog.D{Root: vecty.Markup(prop.ID("my-dialog")), Header: "Title", Body: vecty.Text("Body")}},
+		{"open-scrollable-alert", &dialog.D{Header: "Alert", Body: vecty.Text("Body"), Role: "alertdialog", Open: true, Scrollable: true, NoBackdrop: true}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -0,0 +1,72 @@
+package dialog_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/dialog"
+	"github.com/vecty-material/material/materialtest"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	open, accept := false, false
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &dialog.D{
+			Header:     "Discard draft?",
+			Open:       open,
+			Controlled: true,
+			OnAccept: func(this *dialog.D, e *vecty.Event) {
+				if !this.Open {
+					t.Error("Open = false in OnAccept")
+				}
+				if accept {
+					open = false
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	d := s.ByClass("mdc-dialog")[0]
+	acceptBtn := s.ByClass("mdc-dialog__footer__button--accept")[0]
+
+	// Rendering the dialog with a new value opens it.
+	open = true
+	vecty.Rerender(a)
+	materialtest.AssertClass(t, d, "mdc-dialog--open")
+
+	// A rejected close is undone.
+	materialtest.Click(acceptBtn)
+	materialtest.AssertClass(t, d, "mdc-dialog--open")
+
+	accept = true
+	materialtest.Click(acceptBtn)
+	materialtest.AssertNoClass(t, d, "mdc-dialog--open")
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/bind"
 	"github.com/vecty-material/material/material/persistentdrawer"
 	"github.com/vecty-material/material/material/temporarydrawer"
 )
@@ -17,21 +18,37 @@ const (
 )
 
 // D is a vecty-material drawer component.
+//
+// By default the user opening or closing a temporary or persistent drawer
+// updates Open before OnOpen or OnClose is called. If Controlled is set, Open
+// is left unchanged and the drawer keeps showing it: the callbacks report that
+// the user asked for the drawer to open or close, and it only does when it is
+// rendered with a new Open value.
+//
+// If Bind is set, Open is read from it on render, and the state the user asked
+// for is written to it when the drawer opens or closes.
 type D struct {
 	*base.MDC
 	vecty.Core
-	Root vecty.MarkupOrChild
-	Type
-	Open          bool
-	BelowToolbar  bool
-	Toolbar       vecty.ComponentOrHTML
-	Header        vecty.ComponentOrHTML
-	ToolbarSpacer vecty.ComponentOrHTML
-	Content       vecty.ComponentOrHTML
+	Root          vecty.MarkupOrChild `vecty:"prop"`
+	Type          `vecty:"prop"`
+	Open          bool                          `vecty:"prop"`
+	BelowToolbar  bool                          `vecty:"prop"`
+	Toolbar       vecty.ComponentOrHTML         `vecty:"prop"`
+	Header        vecty.ComponentOrHTML         `vecty:"prop"`
+	ToolbarSpacer vecty.ComponentOrHTML         `vecty:"prop"`
+	Content       vecty.ComponentOrHTML         `vecty:"prop"`
+	OnOpen        func(this *D, e *vecty.Event) `vecty:"prop"`
+	OnClose       func(this *D, e *vecty.Event) `vecty:"prop"`
+	Controlled    bool                          `vecty:"prop"`
+	Bind          *bind.Bool                    `vecty:"prop"`
+	bound         bind.Binding
 }
 
 // Render implements the vecty.Component interface.
 func (c *D) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Open)
+
 	rootMarkup := base.MarkupOnly(c.Root)
 	if c.Root != nil && rootMarkup == nil {
 		// User supplied root element.
@@ -40,7 +57,7 @@ func (c *D) Render() vecty.ComponentOrHTML {
 
 	markup := vecty.Markup(
 		c,
-		vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+		vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 	)
 
 	// Built-in root element.
@@ -85,15 +102,57 @@ func (c *D) Apply(h *vecty.HTML) {
 	case Permanent:
 		markup = append(markup, vecty.Class("mdc-drawer--permanent"))
 	case Temporary:
-		markup = append(markup, vecty.Class("mdc-drawer--temporary"))
+		markup = append(markup, vecty.Class("mdc-drawer--temporary"),
+			c.listeners("MDCTemporaryDrawer"))
 	case Persistent:
-		markup = append(markup, vecty.Class("mdc-drawer--persistent"))
+		markup = append(markup, vecty.Class("mdc-drawer--persistent"),
+			c.listeners("MDCPersistentDrawer"))
 	}
 
 	vecty.Markup(markup...).Apply(h)
 	c.MDC.RootElement = h
 }
 
+// Unmount implements the vecty.Unmounter interface.
+func (c *D) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
+}
+
+// listeners returns the listeners for the open and close events of the MDC
+// component class.
+func (c *D) listeners(class string) vecty.MarkupList {
+	return vecty.Markup(
+		&vecty.EventListener{
+			Name: class + ":open",
+			Listener: func(e *vecty.Event) {
+				c.toggled(true, e, c.OnOpen)
+			},
+		},
+		&vecty.EventListener{
+			Name: class + ":close",
+			Listener: func(e *vecty.Event) {
+				c.toggled(false, e, c.OnClose)
+			},
+		},
+	)
+}
+
+// toggled handles the drawer being opened or closed, calling fn.
+func (c *D) toggled(open bool, e *vecty.Event, fn func(this *D,
+	e *vecty.Event)) {
+	if !c.Controlled {
+		c.Open = open
+	}
+	c.Bind.Set(open)
+	if fn != nil {
+		fn(c, e)
+	}
+	if c.Controlled && c.Open != open {
+		c.MDC.Restore("open", c.Open)
+	}
+}
+
 func (c *D) renderDrawer() vecty.List {
 	var elements []vecty.ComponentOrHTML
 	if c.ToolbarSpacer != nil {
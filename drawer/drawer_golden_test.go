@@ -0,0 +1,24 @@
+package drawer_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/drawer"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"temporary", &drawer.D{Header: elem.Div(vecty.Text("Header")), Content: elem.Div(vecty.Text("Content"))}},
+		{"persistent-open", &drawer.D{Type: drawer.Persistent, Open: true, Header: elem.Div(vecty.Text("Header")), Content: elem.Div(vecty.Text("Content"))}},
+		{"permanent-toolbar-spacer", &drawer.D{Type: drawer.Permanent, ToolbarSpacer: elem.Div(), Content: elem.Div(vecty.Text("Content"))}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
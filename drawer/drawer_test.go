@@ -0,0 +1,69 @@
+package drawer_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/drawer"
+	"github.com/vecty-material/material/materialtest"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	open, accept := false, false
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &drawer.D{
+			Type:       drawer.Temporary,
+			Open:       open,
+			Content:    elem.Div(vecty.Text("Content")),
+			Controlled: true,
+			OnClose: func(this *drawer.D, e *vecty.Event) {
+				if accept {
+					open = false
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	d := s.ByClass("mdc-drawer")[0]
+
+	// Rendering the drawer with a new value opens it.
+	open = true
+	vecty.Rerender(a)
+	materialtest.AssertClass(t, d, "mdc-drawer--open")
+
+	// A rejected close is undone.
+	materialtest.Dispatch(d, "MDCTemporaryDrawer:close", nil)
+	materialtest.AssertClass(t, d, "mdc-drawer--open")
+
+	accept = true
+	materialtest.Dispatch(d, "MDCTemporaryDrawer:close", nil)
+	materialtest.AssertNoClass(t, d, "mdc-drawer--open")
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -0,0 +1,321 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"syscall/js"
+)
+
+// field is a struct field bound to the controls with its name.
+type field struct {
+	name     string
+	index    []int
+	required bool
+	min, max *float64
+}
+
+// fieldsOf returns the bound fields of the struct v points to.
+func fieldsOf(v interface{}) ([]*field, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() ||
+		rv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("Value must be a non-nil pointer to a struct.")
+	}
+	var fields []*field
+	t := rv.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || sf.Anonymous {
+			continue
+		}
+		tag := sf.Tag.Get("form")
+		if tag == "-" {
+			continue
+		}
+		if !supported(sf.Type) {
+			if tag == "" {
+				continue
+			}
+			return nil, fmt.Errorf("Field %s has unsupported type %s.",
+				sf.Name, sf.Type)
+		}
+		f, err := parseTag(sf.Name, tag)
+		if err != nil {
+			return nil, err
+		}
+		f.index = sf.Index
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// parseTag returns the field described by the form struct tag of the field
+// fieldName.
+func parseTag(fieldName, tag string) (*field, error) {
+	opts := strings.Split(tag, ",")
+	f := &field{name: opts[0]}
+	if f.name == "" {
+		f.name = fieldName
+	}
+	for _, o := range opts[1:] {
+		key, val := o, ""
+		if i := strings.Index(o, "="); i >= 0 {
+			key, val = o[:i], o[i+1:]
+		}
+		switch key {
+		case "required":
+			f.required = true
+		case "min", "max":
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Field %s has invalid %s %q.",
+					fieldName, key, val)
+			}
+			if key == "min" {
+				f.min = &n
+			} else {
+				f.max = &n
+			}
+		default:
+			return nil, fmt.Errorf("Field %s has unknown option %q.",
+				fieldName, key)
+		}
+	}
+	return f, nil
+}
+
+func supported(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+// value returns the field f of the struct s.
+func (f *field) value(s reflect.Value) reflect.Value {
+	return s.FieldByIndex(f.index)
+}
+
+// set sets v from the controls ctls.
+func (f *field) set(v reflect.Value, ctls []js.Value) error {
+	var vals []string
+	checkable, checked := false, false
+	for _, e := range ctls {
+		cv, ok, ch := controlValues(e)
+		vals = append(vals, cv...)
+		checkable = checkable || ok
+		checked = checked || ch
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if checkable {
+			v.SetBool(checked)
+			return nil
+		}
+		b, err := strconv.ParseBool(first(vals))
+		v.SetBool(err == nil && b)
+	case reflect.String:
+		v.SetString(first(vals))
+	case reflect.Slice:
+		setStrings(v, vals)
+	case reflect.Float32, reflect.Float64:
+		s := strings.TrimSpace(first(vals))
+		if s == "" {
+			v.SetFloat(0)
+			return nil
+		}
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			v.SetFloat(0)
+			return errors.New("Must be a number.")
+		}
+		v.SetFloat(n)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		s := strings.TrimSpace(first(vals))
+		if s == "" {
+			v.SetInt(0)
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			v.SetInt(0)
+			return errors.New("Must be a whole number.")
+		}
+		v.SetInt(n)
+	default:
+		s := strings.TrimSpace(first(vals))
+		if s == "" {
+			v.SetUint(0)
+			return nil
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			v.SetUint(0)
+			return errors.New("Must be a positive whole number.")
+		}
+		v.SetUint(n)
+	}
+	return nil
+}
+
+// write sets the native controls among ctls from v.
+func (f *field) write(v reflect.Value, ctls []js.Value) {
+	var vals []string
+	switch v.Kind() {
+	case reflect.Slice:
+		vals = stringsOf(v)
+	default:
+		vals = []string{format(v)}
+	}
+	has := func(s string) bool {
+		for _, v := range vals {
+			if v == s {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, e := range ctls {
+		switch tag(e) {
+		case "input":
+			switch strings.ToLower(e.Get("type").String()) {
+			case "checkbox":
+				if v.Kind() == reflect.Bool {
+					e.Set("checked", v.Bool())
+				} else {
+					e.Set("checked", has(e.Get("value").String()))
+				}
+			case "radio":
+				e.Set("checked", has(e.Get("value").String()))
+			default:
+				e.Set("value", first(vals))
+			}
+		case "textarea":
+			e.Set("value", first(vals))
+		case "select":
+			opts := e.Get("options")
+			for i := 0; i < opts.Get("length").Int(); i++ {
+				o := opts.Call("item", i)
+				o.Set("selected", has(o.Get("value").String()))
+			}
+		}
+	}
+}
+
+// setStrings sets v, a slice whose elements are of a string kind, to vals. The
+// elements are set one by one, as a []string cannot be converted to a slice of
+// a named string type such as []Topic. v is set to nil if vals is nil.
+func setStrings(v reflect.Value, vals []string) {
+	if vals == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	s := reflect.MakeSlice(v.Type(), len(vals), len(vals))
+	for i, val := range vals {
+		s.Index(i).SetString(val)
+	}
+	v.Set(s)
+}
+
+// stringsOf returns the elements of v, a slice whose elements are of a string
+// kind.
+func stringsOf(v reflect.Value) []string {
+	vals := make([]string, v.Len())
+	for i := range vals {
+		vals[i] = v.Index(i).String()
+	}
+	return vals
+}
+
+// controlValues returns the values of the control e, whether it is a checkbox
+// or radio, and whether it is checked.
+func controlValues(e js.Value) (vals []string, checkable, checked bool) {
+	switch tag(e) {
+	case "input":
+		switch strings.ToLower(e.Get("type").String()) {
+		case "checkbox", "radio":
+			if e.Get("checked").Bool() {
+				return []string{e.Get("value").String()}, true, true
+			}
+			return nil, true, false
+		}
+		return []string{e.Get("value").String()}, false, false
+	case "textarea":
+		return []string{e.Get("value").String()}, false, false
+	case "select":
+		opts := e.Get("selectedOptions")
+		for i := 0; i < opts.Get("length").Int(); i++ {
+			vals = append(vals, opts.Call("item", i).Get("value").String())
+		}
+		return vals, false, false
+	}
+
+	switch {
+	case e.Get("classList").Call("contains", "mdc-select").Bool():
+		// Like MDCSelect, the value of an option is its id or its text.
+		o := e.Call("querySelector", `[role="option"][aria-selected="true"]`)
+		if o.IsNull() {
+			return nil, false, false
+		}
+		if id := o.Get("id").String(); id != "" {
+			return []string{id}, false, false
+		}
+		return []string{strings.TrimSpace(o.Get("textContent").String())},
+			false, false
+	case e.Call("hasAttribute", "aria-valuenow").Bool():
+		// A slider.
+		return []string{e.Call("getAttribute", "aria-valuenow").String()},
+			false, false
+	}
+	return nil, false, false
+}
+
+// controls returns the elements in root whose name attribute is name.
+func controls(root js.Value, name string) []js.Value {
+	q := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(name)
+	nodes := root.Call("querySelectorAll", `[name="`+q+`"]`)
+	ctls := make([]js.Value, nodes.Get("length").Int())
+	for i := range ctls {
+		ctls[i] = nodes.Call("item", i)
+	}
+	return ctls
+}
+
+func format(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.String:
+		return v.String()
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+	return strconv.FormatUint(v.Uint(), 10)
+}
+
+func first(vals []string) string {
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+func tag(e js.Value) string {
+	return strings.ToLower(e.Get("tagName").String())
+}
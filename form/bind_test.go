@@ -0,0 +1,96 @@
+package form
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTag(t *testing.T) {
+	one, five := 1.0, 5.0
+	tests := []struct {
+		tag     string
+		want    *field
+		wantErr string
+	}{
+		{tag: "", want: &field{name: "Field"}},
+		{tag: "email", want: &field{name: "email"}},
+		{tag: ",required", want: &field{name: "Field", required: true}},
+		{tag: "n,min=1,max=5", want: &field{name: "n", min: &one, max: &five}},
+		{tag: "n,min=x", wantErr: `Field Field has invalid min "x".`},
+		{tag: "n,max", wantErr: `Field Field has invalid max "".`},
+		{tag: "n,maximum=5", wantErr: `Field Field has unknown option "maximum".`},
+	}
+	for _, tt := range tests {
+		got, err := parseTag("Field", tt.tag)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("parseTag(%q) error = %v, want %s", tt.tag, err,
+					tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseTag(%q) error = %v", tt.tag, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTag(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestFieldsOf(t *testing.T) {
+	type ok struct {
+		Name     string `form:"name,required"`
+		Tags     []string
+		Skipped  string `form:"-"`
+		Untagged map[string]bool
+		Count    int
+		hidden   string
+	}
+	type unsupported struct {
+		Data map[string]bool `form:"data"`
+	}
+	type badTag struct {
+		Count int `form:"count,min=one"`
+	}
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    []string
+		wantErr string
+	}{
+		{name: "ok", v: &ok{}, want: []string{"name", "Tags", "Count"}},
+		{name: "struct", v: ok{},
+			wantErr: "Value must be a non-nil pointer to a struct."},
+		{name: "nil", v: (*ok)(nil),
+			wantErr: "Value must be a non-nil pointer to a struct."},
+		{name: "pointer", v: new(int),
+			wantErr: "Value must be a non-nil pointer to a struct."},
+		{name: "unsupported", v: &unsupported{},
+			wantErr: "Field Data has unsupported type map[string]bool."},
+		{name: "bad tag", v: &badTag{},
+			wantErr: `Field Count has invalid min "one".`},
+	}
+	for _, tt := range tests {
+		fields, err := fieldsOf(tt.v)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s: fieldsOf() error = %v, want %s", tt.name, err,
+					tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: fieldsOf() error = %v", tt.name, err)
+			continue
+		}
+		var got []string
+		for _, f := range fields {
+			got = append(got, f.name)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: fieldsOf() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
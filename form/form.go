@@ -0,0 +1,315 @@
+// form binds the fields of a Go struct to the inputs of a form, tracks which
+// fields the user has changed or visited, validates them, and hands the
+// populated struct to a submit callback.
+//
+// Fields are bound by name. The name of a field is set with a struct tag, or
+// is the field name if there is none, and matches the name attribute of the
+// controls in the form:
+//
+//	type Signup struct {
+//		Email      string   `form:"email,required"`
+//		Plan       string   `form:"plan,required"`
+//		Topics     []string `form:"topics,min=1"`
+//		Newsletter bool     `form:"newsletter"`
+//		Seats      int      `form:"seats,min=1,max=50"`
+//		Internal   string   `form:"-"`
+//	}
+//
+// Checkboxes and switches bind to bool fields, or to []string fields holding
+// the values of the checked boxes. Radios, text fields and selects bind to
+// string or number fields, and sliders to number fields. Set the name of a
+// vecty-material component's control with Name, for example
+// checkbox.CB{Input: vecty.Markup(form.Name("newsletter"))}, or on the root
+// element of an MDC slider or select.
+//
+// The tag options are:
+//
+//	required  the value must not be the zero value
+//	min=N     numbers must be at least N, strings and slices at least N long
+//	max=N     numbers must be at most N, strings and slices at most N long
+//
+// If Value is not a pointer to a struct, or its form tags are invalid, F binds
+// no field: Errors holds the error under the empty name, and the form is never
+// submitted.
+//
+// F writes the values of the struct to the native controls when it is
+// mounted and on Reset. MDC sliders and selects have no native control, their
+// values are only read; set their initial value on the component.
+package form // import "github.com/vecty-material/material/form"
+
+import (
+	"reflect"
+
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/vecty-material/material/base"
+)
+
+// events are the events F listens for on its form element, in the capture
+// phase so events of MDC components that do not bubble are seen too.
+var events = []string{
+	"input",
+	"change",
+	"focusout",
+	"MDCSlider:input",
+	"MDCSlider:change",
+	"MDCSelect:change",
+}
+
+// F is a vecty-material form component. It renders a form element containing
+// Body, and binds the struct Value points to to the controls in it.
+type F struct {
+	vecty.Core
+	Root vecty.MarkupOrChild
+	Body vecty.ComponentOrHTML
+
+	// Value is a pointer to the struct the form is bound to.
+	Value interface{}
+
+	// Validate, if set, is called with Value after the validation of the
+	// struct tags, and returns errors keyed by field name.
+	Validate func(value interface{}) map[string]error
+
+	// OnChange is called after the value, touched state or errors of a field
+	// change, and after a submit that failed validation.
+	OnChange func(this *F)
+
+	// OnSubmit is called with Value when the form is submitted and every
+	// field is valid.
+	OnSubmit func(this *F, value interface{}, e *vecty.Event)
+
+	form      *vecty.HTML
+	err       error
+	fields    []*field
+	initial   reflect.Value
+	touched   map[string]bool
+	errs      map[string]error
+	parseErrs map[string]error
+	listener  js.Func
+}
+
+// Name returns markup that sets the name attribute of a control, binding it to
+// the form field name.
+func Name(name string) vecty.Applyer {
+	return vecty.Attribute("name", name)
+}
+
+// Render implements the vecty.Component interface.
+func (c *F) Render() vecty.ComponentOrHTML {
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		c.form = elem.Form(c.Root)
+		return c.form
+	}
+
+	c.form = elem.Form(
+		vecty.Markup(
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			// Validation is done by F, not the browser.
+			vecty.Attribute("novalidate", true),
+			event.Submit(c.onSubmit).PreventDefault(),
+		),
+		c.Body,
+	)
+	return c.form
+}
+
+// Mount implements the vecty.Mounter interface.
+func (c *F) Mount() {
+	c.touched = map[string]bool{}
+	c.parseErrs = map[string]error{}
+	c.fields, c.err = fieldsOf(c.Value)
+	if c.err != nil {
+		c.validate()
+		c.changed()
+		return
+	}
+	c.initial = snapshot(c.Value)
+	c.write()
+	c.validate()
+
+	c.listener = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		c.onEvent(args[0])
+		return nil
+	})
+	n := c.form.Node()
+	for _, e := range events {
+		n.Call("addEventListener", e, c.listener, true)
+	}
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (c *F) Unmount() {
+	if c.err != nil {
+		// Not listening.
+		return
+	}
+	n := c.form.Node()
+	for _, e := range events {
+		n.Call("removeEventListener", e, c.listener, true)
+	}
+	c.listener.Release()
+}
+
+// Dirty reports whether the value of the field name differs from its value
+// when the form was mounted or last reset.
+func (c *F) Dirty(name string) bool {
+	f := c.field(name)
+	if f == nil {
+		return false
+	}
+	return !reflect.DeepEqual(f.value(c.initial).Interface(),
+		f.value(reflect.ValueOf(c.Value).Elem()).Interface())
+}
+
+// Touched reports whether the user has visited and left the control of the
+// field name, or has tried to submit the form.
+func (c *F) Touched(name string) bool {
+	return c.touched[name]
+}
+
+// Err returns the validation error of the field name, or nil if it is valid.
+// The error of an invalid Value has the empty name.
+func (c *F) Err(name string) error {
+	return c.errs[name]
+}
+
+// Errors returns the validation errors of the form, keyed by field name.
+func (c *F) Errors() map[string]error {
+	return c.errs
+}
+
+// Valid reports whether every field of the form is valid.
+func (c *F) Valid() bool {
+	return len(c.errs) == 0
+}
+
+// Reset restores the values the struct had when the form was mounted or last
+// reset, writes them to the controls, and clears the touched state.
+func (c *F) Reset() {
+	if c.err != nil {
+		return
+	}
+	reflect.ValueOf(c.Value).Elem().Set(c.initial)
+	c.initial = snapshot(c.Value)
+	c.touched = map[string]bool{}
+	c.parseErrs = map[string]error{}
+	c.write()
+	c.validate()
+	c.changed()
+}
+
+func (c *F) onEvent(e js.Value) {
+	t := e.Get("target")
+	if t.Get("closest").IsUndefined() {
+		return
+	}
+	ctl := t.Call("closest", "[name]")
+	if ctl.IsNull() || !c.form.Node().Call("contains", ctl).Bool() {
+		return
+	}
+	f := c.field(ctl.Call("getAttribute", "name").String())
+	if f == nil {
+		return
+	}
+	if e.Get("type").String() == "focusout" {
+		if c.touched[f.name] {
+			return
+		}
+		c.touched[f.name] = true
+	} else {
+		c.read(f)
+	}
+	c.validate()
+	c.changed()
+}
+
+func (c *F) onSubmit(e *vecty.Event) {
+	for _, f := range c.fields {
+		c.read(f)
+		c.touched[f.name] = true
+	}
+	c.validate()
+	if !c.Valid() {
+		c.changed()
+		return
+	}
+	if c.OnSubmit != nil {
+		c.OnSubmit(c, c.Value, e)
+	}
+}
+
+func (c *F) changed() {
+	if c.OnChange != nil {
+		c.OnChange(c)
+	}
+}
+
+func (c *F) field(name string) *field {
+	for _, f := range c.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// read sets the field f of the struct from its controls.
+func (c *F) read(f *field) {
+	ctls := controls(c.form.Node(), f.name)
+	if len(ctls) == 0 {
+		return
+	}
+	v := f.value(reflect.ValueOf(c.Value).Elem())
+	if err := f.set(v, ctls); err != nil {
+		c.parseErrs[f.name] = err
+		return
+	}
+	delete(c.parseErrs, f.name)
+}
+
+// write sets the controls of every field from the struct.
+func (c *F) write() {
+	v := reflect.ValueOf(c.Value).Elem()
+	for _, f := range c.fields {
+		f.write(f.value(v), controls(c.form.Node(), f.name))
+	}
+}
+
+func (c *F) validate() {
+	c.errs = map[string]error{}
+	if c.err != nil {
+		c.errs[""] = c.err
+		return
+	}
+	v := reflect.ValueOf(c.Value).Elem()
+	for _, f := range c.fields {
+		if err := c.parseErrs[f.name]; err != nil {
+			c.errs[f.name] = err
+			continue
+		}
+		if err := f.validate(f.value(v)); err != nil {
+			c.errs[f.name] = err
+		}
+	}
+	if c.Validate == nil {
+		return
+	}
+	for name, err := range c.Validate(c.Value) {
+		if _, ok := c.errs[name]; !ok && err != nil {
+			c.errs[name] = err
+		}
+	}
+}
+
+// snapshot returns a copy of the struct v points to.
+func snapshot(v interface{}) reflect.Value {
+	s := reflect.ValueOf(v).Elem()
+	c := reflect.New(s.Type()).Elem()
+	c.Set(s)
+	return c
+}
@@ -0,0 +1,76 @@
+package form_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/form"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/radio"
+)
+
+type signup struct {
+	Email      string `form:"email,required"`
+	Plan       string `form:"plan,required"`
+	Seats      int    `form:"seats,min=1,max=50"`
+	Newsletter bool   `form:"newsletter"`
+}
+
+func Example() {
+	v := &signup{Plan: "basic", Seats: 1}
+	f := &form.F{
+		Value: v,
+		Body: elem.Div(
+			elem.Input(vecty.Markup(prop.ID("email"), form.Name("email"))),
+			&radio.R{Name: "plan", Value: "basic"},
+			&radio.R{Name: "plan", Value: "pro",
+				Input: vecty.Markup(prop.ID("pro"))},
+			elem.Input(vecty.Markup(prop.ID("seats"), form.Name("seats"))),
+			&checkbox.CB{Input: vecty.Markup(prop.ID("newsletter"),
+				form.Name("newsletter"))},
+		),
+		OnSubmit: func(this *form.F, value interface{}, e *vecty.Event) {
+			fmt.Printf("Submitted: %+v\n", *value.(*signup))
+		},
+	}
+	s, err := materialtest.Mount(f)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+
+	// Struct values are written to the controls on mount.
+	fmt.Printf("Seats input: %v\n", s.ByID("seats").Get("value"))
+
+	// Submitting without an email fails validation.
+	materialtest.Dispatch(s.Query("form"), "submit", nil)
+	fmt.Printf("Valid: %v, email: %v\n", f.Valid(), f.Err("email"))
+
+	materialtest.Input(s.ByID("email"), "gopher@example.com")
+	materialtest.Input(s.ByID("seats"), "60")
+	fmt.Printf("Dirty: %v, seats: %v\n", f.Dirty("email"), f.Err("seats"))
+
+	materialtest.Input(s.ByID("seats"), "5")
+	materialtest.Click(s.ByID("pro"))
+	materialtest.Click(s.ByID("newsletter"))
+	materialtest.Dispatch(s.Query("form"), "submit", nil)
+
+	// Output:
+	// Seats input: 1
+	// Valid: false, email: Required.
+	// Dirty: true, seats: Must be at most 50.
+	// Submitted: {Email:gopher@example.com Plan:pro Seats:5 Newsletter:true}
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package form_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/form"
+	"github.com/vecty-material/material/materialtest"
+)
+
+type prefs struct {
+	Plan   string   `form:"plan"`
+	Size   string   `form:"size"`
+	Volume float64  `form:"volume"`
+	Dark   bool     `form:"dark"`
+	Topics []string `form:"topics,min=1"`
+	Name   string   `form:"name"`
+}
+
+func prefsBody() vecty.ComponentOrHTML {
+	checkbox := func(id, name, value string) *vecty.HTML {
+		return elem.Input(vecty.Markup(prop.ID(id), form.Name(name),
+			prop.Type(prop.TypeCheckbox), prop.Value(value)))
+	}
+	option := func(id string, selected bool) *vecty.HTML {
+		return elem.ListItem(vecty.Markup(prop.ID(id),
+			vecty.Attribute("role", "option"),
+			vecty.Attribute("aria-selected", selected)))
+	}
+	return elem.Div(
+		elem.Select(
+			vecty.Markup(prop.ID("plan"), form.Name("plan")),
+			elem.Option(vecty.Markup(prop.Value("basic"))),
+			elem.Option(vecty.Markup(prop.Value("pro"))),
+		),
+		// An MDC select.
+		elem.Div(
+			vecty.Markup(prop.ID("size"), vecty.Class("mdc-select"),
+				form.Name("size")),
+			elem.UnorderedList(option("small", true), option("large", false)),
+		),
+		// An MDC slider.
+		elem.Div(vecty.Markup(prop.ID("volume"), vecty.Class("mdc-slider"),
+			form.Name("volume"), vecty.Attribute("aria-valuenow", 3))),
+		// An MDC switch.
+		elem.Div(
+			vecty.Markup(vecty.Class("mdc-switch")),
+			checkbox("dark", "dark", "on"),
+		),
+		checkbox("go", "topics", "go"),
+		checkbox("js", "topics", "js"),
+		elem.Input(vecty.Markup(prop.ID("name"), form.Name("name"))),
+	)
+}
+
+func mount(t *testing.T, f *form.F) *materialtest.Screen {
+	s, err := materialtest.Mount(f)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	return s
+}
+
+func TestBinding(t *testing.T) {
+	v := &prefs{Plan: "pro", Topics: []string{"js"}}
+	f := &form.F{Value: v, Body: prefsBody()}
+	s := mount(t, f)
+	defer s.Unmount()
+
+	// Values are written to the native controls on mount.
+	materialtest.AssertProp(t, s.ByID("plan"), "value", "pro")
+	materialtest.AssertProp(t, s.ByID("go"), "checked", false)
+	materialtest.AssertProp(t, s.ByID("js"), "checked", true)
+
+	s.ByID("plan").Set("value", "basic")
+	materialtest.Change(s.ByID("plan"))
+	s.ByID("small").Call("setAttribute", "aria-selected", "false")
+	s.ByID("large").Call("setAttribute", "aria-selected", "true")
+	materialtest.Dispatch(s.ByID("size"), "MDCSelect:change", nil)
+	s.ByID("volume").Call("setAttribute", "aria-valuenow", "7.5")
+	materialtest.Dispatch(s.ByID("volume"), "MDCSlider:change", nil)
+	materialtest.Click(s.ByID("dark"))
+	materialtest.Click(s.ByID("go"))
+
+	want := prefs{Plan: "basic", Size: "large", Volume: 7.5, Dark: true,
+		Topics: []string{"go", "js"}}
+	if !reflect.DeepEqual(*v, want) {
+		t.Errorf("Value = %+v, want %+v", *v, want)
+	}
+
+	// Unchecking every topic fails min=1.
+	materialtest.Click(s.ByID("go"))
+	materialtest.Click(s.ByID("js"))
+	if len(v.Topics) != 0 {
+		t.Errorf("Topics = %v, want none", v.Topics)
+	}
+	if err := f.Err("topics"); err == nil ||
+		err.Error() != "Must be at least 1 selected." {
+		t.Errorf("Err(%q) = %v", "topics", err)
+	}
+}
+
+type topic string
+
+func TestNamedStrings(t *testing.T) {
+	v := &struct {
+		Topics []topic `form:"topics"`
+	}{Topics: []topic{"js"}}
+	checkbox := func(id string) *vecty.HTML {
+		return elem.Input(vecty.Markup(prop.ID(id), form.Name("topics"),
+			prop.Type(prop.TypeCheckbox), prop.Value(id)))
+	}
+	f := &form.F{Value: v, Body: elem.Div(checkbox("go"), checkbox("js"))}
+	s := mount(t, f)
+	defer s.Unmount()
+
+	materialtest.AssertProp(t, s.ByID("go"), "checked", false)
+	materialtest.AssertProp(t, s.ByID("js"), "checked", true)
+	materialtest.Click(s.ByID("go"))
+	if want := []topic{"go", "js"}; !reflect.DeepEqual(v.Topics, want) {
+		t.Errorf("Topics = %v, want %v", v.Topics, want)
+	}
+}
+
+func TestReset(t *testing.T) {
+	v := &prefs{Name: "gopher", Topics: []string{"go"}}
+	changes := 0
+	f := &form.F{
+		Value:    v,
+		Body:     prefsBody(),
+		OnChange: func(this *form.F) { changes++ },
+	}
+	s := mount(t, f)
+	defer s.Unmount()
+	name := s.ByID("name")
+
+	if f.Touched("name") {
+		t.Error("Touched before the user visited the field")
+	}
+	materialtest.Input(name, "ferris")
+	// The version of jsdom used does not fire focusout on blur.
+	materialtest.Dispatch(name, "focusout", nil)
+	if !f.Touched("name") || !f.Dirty("name") || v.Name != "ferris" {
+		t.Errorf("Touched = %v, Dirty = %v, Name = %q after input",
+			f.Touched("name"), f.Dirty("name"), v.Name)
+	}
+
+	changes = 0
+	f.Reset()
+	if v.Name != "gopher" || name.Get("value").String() != "gopher" {
+		t.Errorf("Name = %q, input value = %v after Reset", v.Name,
+			name.Get("value"))
+	}
+	if f.Touched("name") || f.Dirty("name") {
+		t.Errorf("Touched = %v, Dirty = %v after Reset", f.Touched("name"),
+			f.Dirty("name"))
+	}
+	if changes != 1 {
+		t.Errorf("OnChange called %d times by Reset, want 1", changes)
+	}
+
+	// Reset makes the current values the initial ones.
+	materialtest.Input(name, "gopher2")
+	if !f.Dirty("name") {
+		t.Error("not Dirty after input following Reset")
+	}
+	materialtest.Input(name, "gopher")
+	if f.Dirty("name") {
+		t.Error("Dirty after restoring the value following Reset")
+	}
+}
+
+func TestInvalidValue(t *testing.T) {
+	type bad struct {
+		Seats int `form:"seats,min=one"`
+	}
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"tag", &bad{}, `Field Seats has invalid min "one".`},
+		{"not a pointer", bad{}, "Value must be a non-nil pointer to a struct."},
+	}
+	for _, tt := range tests {
+		changed, submitted := false, false
+		f := &form.F{
+			Value: tt.value,
+			Body: elem.Input(vecty.Markup(prop.ID("seats"),
+				form.Name("seats"))),
+			OnChange: func(this *form.F) { changed = true },
+			OnSubmit: func(this *form.F, value interface{}, e *vecty.Event) {
+				submitted = true
+			},
+		}
+		s := mount(t, f)
+		materialtest.Input(s.ByID("seats"), "5")
+		materialtest.Dispatch(s.Query("form"), "submit", nil)
+		if err := f.Err(""); err == nil || err.Error() != tt.want {
+			t.Errorf("%s: Err(%q) = %v, want %s", tt.name, "", err, tt.want)
+		}
+		if !changed || submitted || f.Valid() {
+			t.Errorf("%s: changed = %v, submitted = %v, Valid = %v", tt.name,
+				changed, submitted, f.Valid())
+		}
+		s.Unmount()
+	}
+}
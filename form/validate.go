@@ -0,0 +1,46 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// validate returns the error of v according to the tag options of f, or nil.
+func (f *field) validate(v reflect.Value) error {
+	if f.required && (v.Kind() == reflect.Slice && v.Len() == 0 ||
+		reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())) {
+		return errors.New("Required.")
+	}
+
+	var n float64
+	unit := ""
+	switch v.Kind() {
+	case reflect.Bool:
+		return nil
+	case reflect.String:
+		n, unit = float64(utf8.RuneCountInString(v.String())), " characters"
+	case reflect.Slice:
+		n, unit = float64(v.Len()), " selected"
+	case reflect.Float32, reflect.Float64:
+		n = v.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		n = float64(v.Int())
+	default:
+		n = float64(v.Uint())
+	}
+	if f.min != nil && n < *f.min {
+		return fmt.Errorf("Must be at least %s%s.", num(*f.min), unit)
+	}
+	if f.max != nil && n > *f.max {
+		return fmt.Errorf("Must be at most %s%s.", num(*f.max), unit)
+	}
+	return nil
+}
+
+func num(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
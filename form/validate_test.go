@@ -0,0 +1,41 @@
+package form
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	two, four := 2.0, 4.0
+	tests := []struct {
+		name string
+		f    field
+		v    interface{}
+		want string
+	}{
+		{"required string", field{required: true}, "", "Required."},
+		{"required set", field{required: true}, "a", ""},
+		{"required int", field{required: true}, 0, "Required."},
+		{"required slice", field{required: true}, []string{}, "Required."},
+		{"bool", field{min: &two}, false, ""},
+		{"min string", field{min: &two}, "é", "Must be at least 2 characters."},
+		{"max string", field{max: &two}, "abc", "Must be at most 2 characters."},
+		{"min slice", field{min: &two}, []string{"a"},
+			"Must be at least 2 selected."},
+		{"max slice", field{max: &two}, []string{"a", "b"}, ""},
+		{"min int", field{min: &two}, 1, "Must be at least 2."},
+		{"max uint", field{max: &four}, uint8(5), "Must be at most 4."},
+		{"float", field{min: &two, max: &four}, 2.5, ""},
+		{"max float", field{max: &four}, 4.5, "Must be at most 4."},
+	}
+	for _, tt := range tests {
+		err := tt.f.validate(reflect.ValueOf(tt.v))
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("%s: validate() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
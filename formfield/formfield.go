@@ -9,14 +9,24 @@ import (
 	"github.com/vecty-material/material/material/formfield"
 )
 
-// FF is a vecty-material formfield component.
+// FF is a vecty-material formfield component. Its label is associated with
+// the ID of the first native input in Input, which may be wrapped in lists or
+// components but not in elements. The ID is allocated with base.AutoID if it
+// has none.
 type FF struct {
 	*base.MDC
 	vecty.Core
-	Root     vecty.MarkupOrChild
-	Input    vecty.ComponentOrHTML
-	Label    string
-	AlignEnd bool
+	Root     vecty.MarkupOrChild   `vecty:"prop"`
+	Input    vecty.ComponentOrHTML `vecty:"prop"`
+	Label    string                `vecty:"prop"`
+	AlignEnd bool                  `vecty:"prop"`
+	inputID  string
+}
+
+// inputIDSetter is implemented by components whose native input element can be
+// given an ID, such as checkbox.CB and radio.R.
+type inputIDSetter interface {
+	SetInputID(id string)
 }
 
 // Render implements the vecty.Component interface.
@@ -27,11 +37,14 @@ func (c *FF) Render() vecty.ComponentOrHTML {
 		return elem.Div(c.Root)
 	}
 
-	inputID := applyer.FindID(c.Input)
+	input, inputID := applyer.FindInput(c.Input)
+	if input != nil && inputID == "" {
+		inputID = c.setInputID(input)
+	}
 	return elem.Div(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		c.Input,
 		elem.Label(
@@ -61,3 +74,18 @@ func (c *FF) Apply(h *vecty.HTML) {
 	).Apply(h)
 	c.MDC.RootElement = h
 }
+
+// setInputID gives input, the native input found in c.Input, an ID allocated by
+// base.AutoID so the label can be associated with it, and returns the ID. It
+// returns an empty string if input cannot be given an ID.
+func (c *FF) setInputID(input vecty.MarkupOrChild) string {
+	switch t := input.(type) {
+	case inputIDSetter:
+		t.SetInputID(base.AutoID(&c.inputID))
+	case *vecty.HTML:
+		prop.ID(base.AutoID(&c.inputID)).Apply(t)
+	default:
+		return ""
+	}
+	return applyer.FindID(input)
+}
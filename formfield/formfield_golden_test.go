@@ -0,0 +1,29 @@
+package formfield_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/formfield"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/radio"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"checkbox", &formfield.FF{Label: "Label", Input: &checkbox.CB{Input: vecty.Markup(prop.ID("ff-cb"))}}},
+		{"auto-id", &formfield.FF{Label: "Label", Input: &checkbox.CB{}}},
+		{"list-input", &formfield.FF{Label: "Label", Input: vecty.List{elem.Input(vecty.Markup(prop.Type(prop.TypeText)))}}},
+		{"list-checkbox", &formfield.FF{Label: "Label", Input: vecty.List{&checkbox.CB{Input: vecty.Markup(prop.ID("ff-list"))}}}},
+		{"align-end-radio", &formfield.FF{Label: "Label", AlignEnd: true, Input: &radio.R{Name: "r", Value: "a", Input: vecty.Markup(prop.ID("ff-radio"))}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -11,9 +11,12 @@ func CatchException(err *error) {
 		return
 	}
 
-	if e, ok := e.(*js.Error); ok {
-		*err = e
-	} else {
+	switch t := e.(type) {
+	case js.Error:
+		*err = t
+	case *js.Error:
+		*err = t
+	default:
 		panic(e)
 	}
 }
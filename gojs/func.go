@@ -0,0 +1,74 @@
+package gojs
+
+import "syscall/js"
+
+// Value converts v into a value that can be passed to syscall/js functions
+// such as js.Value.Set and js.Value.Call. Go funcs of the types below are
+// wrapped in a js.Func, maps of type map[string]interface{} are converted
+// recursively, and other values are returned as is.
+//
+//	func()
+//	func(v interface{})
+//	func() interface{}
+//	func(e js.Value)
+//
+// Value does not release the js.Func it creates for a Go func. Callers that
+// replace or discard the result should call its Release method.
+func Value(v interface{}) interface{} {
+	switch t := v.(type) {
+	case func():
+		return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			t()
+			return nil
+		})
+	case func(interface{}):
+		return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			t(arg(args))
+			return nil
+		})
+	case func() interface{}:
+		return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			return Value(t())
+		})
+	case func(js.Value):
+		return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if len(args) == 0 {
+				t(js.Undefined())
+				return nil
+			}
+			t(args[0])
+			return nil
+		})
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, mv := range t {
+			m[k] = Value(mv)
+		}
+		return m
+	}
+	return v
+}
+
+// GoValue converts v into a bool, float64 or string if it is one of the
+// matching JS types, and nil if it is null or undefined. Other values are
+// returned as a js.Value.
+func GoValue(v js.Value) interface{} {
+	switch v.Type() {
+	case js.TypeBoolean:
+		return v.Bool()
+	case js.TypeNumber:
+		return v.Float()
+	case js.TypeString:
+		return v.String()
+	case js.TypeUndefined, js.TypeNull:
+		return nil
+	}
+	return v
+}
+
+func arg(args []js.Value) interface{} {
+	if len(args) == 0 {
+		return nil
+	}
+	return GoValue(args[0])
+}
@@ -0,0 +1,97 @@
+package jsdom
+
+import (
+	"strings"
+	"sync"
+
+	"syscall/js"
+)
+
+// consoleLevels are the console methods a Console captures.
+var consoleLevels = []string{"log", "info", "warn", "error", "debug", "trace"}
+
+// ConsoleMessage is a message written to a window's console.
+type ConsoleMessage struct {
+	// Level is the console method used, for example "log" or "error".
+	// Errors raised by jsdom itself, such as uncaught exceptions in event
+	// handlers, have the level "jsdomError".
+	Level string
+
+	// Text is the message's arguments converted to strings and joined by
+	// spaces.
+	Text string
+}
+
+// Console captures the console output of a JSDOM's window.
+type Console struct {
+	vc       js.Value
+	handlers map[string]js.Func
+	mu       sync.Mutex
+	messages []ConsoleMessage
+}
+
+func newConsole(vc js.Value) *Console {
+	c := &Console{vc: vc, handlers: map[string]js.Func{}}
+	for _, level := range append(consoleLevels, "jsdomError") {
+		level := level
+		fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			c.add(level, args)
+			return nil
+		})
+		vc.Call("on", level, fn)
+		c.handlers[level] = fn
+	}
+	return c
+}
+
+// release stops capturing messages and releases the funcs handling them.
+func (c *Console) release() {
+	for level, fn := range c.handlers {
+		c.vc.Call("removeListener", level, fn)
+		fn.Release()
+		delete(c.handlers, level)
+	}
+}
+
+// VirtualConsole returns the underlying jsdom VirtualConsole.
+func (c *Console) VirtualConsole() js.Value {
+	return c.vc
+}
+
+// SendTo forwards messages to console, for example Node's global console, in
+// addition to capturing them. The virtual console created by New already
+// forwards to Node's console, so SendTo is only needed for one passed in the
+// "virtualConsole" option.
+func (c *Console) SendTo(console js.Value) {
+	c.vc.Call("sendTo", console, M{"omitJSDOMErrors": true})
+}
+
+// Messages returns the messages captured so far.
+func (c *Console) Messages() []ConsoleMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]ConsoleMessage(nil), c.messages...)
+}
+
+// Clear discards the messages captured so far.
+func (c *Console) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = nil
+}
+
+func (c *Console) add(level string, args []js.Value) {
+	text := make([]string, len(args))
+	for i, a := range args {
+		if level == "jsdomError" && a.Type() == js.TypeObject {
+			a = a.Get("message")
+		}
+		text[i] = js.Global().Call("String", a).String()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, ConsoleMessage{
+		Level: level,
+		Text:  strings.Join(text, " "),
+	})
+}
@@ -2,11 +2,13 @@
 // for simulating a browser environment for basic testing purposes, without
 // requiring a full WebDriver setup.
 //
-// Currently the jsdom package most easily used by assigning objects like
-// "window" to Node's global environment. This is not recommended by the jsdom
-// project, and we hope to develop bindings that aid in using jsdom's features
-// for safely running code in the emulated DOM without stuffing things into
-// Node's global environment. See:
+// Each JSDOM has its own window. Code can be run inside that window's context
+// with Eval and LoadModule instead of assigning objects like "window" to Node's
+// global environment, which is not recommended by the jsdom project. This
+// package does not assign any globals itself, so any number of JSDOMs can be
+// used at the same time. Callers may still do so: vecty renders into the global
+// document, so materialtest.EmulateDOM and the ssr package point the globals at
+// a JSDOM's window, and materialtest.Mount renders into it. See:
 // https://github.com/jsdom/jsdom/wiki/Don't-stuff-jsdom-globals-onto-the-Node-global
 
 package jsdom // import "github.com/vecty-material/material/gojs/jsdom"
@@ -17,7 +19,9 @@ import (
 	"github.com/vecty-material/material/gojs"
 )
 
-type M map[string]interface{}
+// M is a map of options or other values passed to JS. It is an alias so that
+// values of type M are accepted by js.ValueOf.
+type M = map[string]interface{}
 
 type JSDOM interface {
 	DOM() js.Value
@@ -27,13 +31,36 @@ type JSDOM interface {
 	PopulateBody(html string) js.Value
 	QueryElement(querySelector string) (e js.Value, err error)
 	RootElement() js.Value
+
+	// Eval runs script in the context of the window and returns the result.
+	Eval(script string) (js.Value, error)
+
+	// LoadModule runs the main file of a Node module, for example
+	// "material-components-web/dist/material-components-web", in the context
+	// of the window. UMD bundles assign their exports to the window.
+	LoadModule(module string) error
+
+	// Console returns the virtual console that captures the window's console
+	// output.
+	Console() *Console
+
+	// Close closes the window, stopping its timers and event listeners, and
+	// stops capturing its console output.
+	Close()
 }
 
 type jsdom struct {
 	js.Value
 	options M
+	console *Console
 }
 
+// New returns a JSDOM for html. options are passed to the jsdom constructor.
+// Unless options say otherwise, scripts may be run with Eval and LoadModule
+// ("runScripts": "outside-only"), and console output is forwarded to Node's
+// console and captured by a virtual console available from Console. Pass a
+// VirtualConsole of your own in the "virtualConsole" option to capture output
+// without forwarding it.
 func New(html string, options *M) (JSDOM, error) {
 	c, err := jsdomClass()
 	if err != nil {
@@ -54,43 +81,81 @@ func jsdomClass() (c js.Value, err error) {
 	return c, err
 }
 
-func newJSDOM(c js.Value, html string, options *M) (j jsdom, err error) {
+func newJSDOM(c js.Value, html string, options *M) (j *jsdom, err error) {
 	defer gojs.CatchException(&err)
-	j.options = *options
-	j.Value = c.Get("JSDOM").New(html, options)
+	j = &jsdom{options: M{}}
+	if options != nil {
+		for k, v := range *options {
+			j.options[k] = v
+		}
+	}
+	if _, ok := j.options["runScripts"]; !ok {
+		j.options["runScripts"] = "outside-only"
+	}
+	vc, ok := j.options["virtualConsole"].(js.Value)
+	if !ok {
+		vc = c.Get("VirtualConsole").New()
+		vc.Call("sendTo", js.Global().Get("console"))
+		j.options["virtualConsole"] = vc
+	}
+	j.console = newConsole(vc)
+	j.Value = c.Get("JSDOM").New(html, j.options)
 	return j, err
 }
 
-func (j jsdom) DOM() js.Value {
+func (j *jsdom) DOM() js.Value {
 	return j.Value
 }
 
-func (j jsdom) Window() js.Value {
+func (j *jsdom) Window() js.Value {
 	return j.Get("window")
 }
 
-func (j jsdom) Document() js.Value {
+func (j *jsdom) Document() js.Value {
 	return j.Window().Get("document")
 }
 
-func (j jsdom) SetHTML(html string) {
+func (j *jsdom) SetHTML(html string) {
 	j.Document().Get("documentElement").Set("innerHTML", html)
 }
 
 // PopulateBody resets documentElement with html inside a valid html/body DOM
 // and returns the HTMLElement of html.
-func (j jsdom) PopulateBody(html string) js.Value {
+func (j *jsdom) PopulateBody(html string) js.Value {
 	j.SetHTML("<html><body>" + html +
 		"</body></html>")
-	return js.Global().Get("document").Get("body").Get("firstElementChild")
+	return j.Document().Get("body").Get("firstElementChild")
 }
 
-func (j jsdom) QueryElement(querySelector string) (e js.Value, err error) {
+func (j *jsdom) QueryElement(querySelector string) (e js.Value, err error) {
 	defer gojs.CatchException(&err)
 	e = j.Document().Call("querySelector", querySelector)
 	return e, err
 }
 
-func (j jsdom) RootElement() js.Value {
+func (j *jsdom) RootElement() js.Value {
 	return j.Document().Get("documentElement")
 }
+
+func (j *jsdom) Eval(script string) (v js.Value, err error) {
+	defer gojs.CatchException(&err)
+	v = j.Window().Call("eval", script)
+	return v, err
+}
+
+func (j *jsdom) LoadModule(module string) (err error) {
+	defer gojs.CatchException(&err)
+	path := js.Global().Get("require").Call("resolve", module)
+	src := js.Global().Call("require", "fs").Call("readFileSync", path, "utf8")
+	_, err = j.Eval(src.String())
+	return err
+}
+
+func (j *jsdom) Console() *Console {
+	return j.console
+}
+
+func (j *jsdom) Close() {
+	j.Window().Call("close")
+	j.console.release()
+}
@@ -1,6 +1,8 @@
 package icon
 
 import (
+	"strconv"
+
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/vecty-material/material/base"
@@ -33,7 +35,7 @@ func (c *I) Render() vecty.ComponentOrHTML {
 	return elem.Italic(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		vecty.If(!isIconCode, vecty.Text(c.Name)),
 	)
@@ -69,12 +71,11 @@ func (c *I) Apply(h *vecty.HTML) {
 }
 
 func (c *I) iconDetails() (sizeClass string, isIconCode bool) {
-	sizeClass = c.MDC.RootElement.Node().Get("SizePX").String()
-	switch sizeClass {
-	case "undefined", "", "24":
+	switch c.SizePX {
+	case 0, 24:
 		sizeClass = ""
 	default:
-		sizeClass = "md-" + sizeClass
+		sizeClass = "md-" + strconv.Itoa(c.SizePX)
 	}
 	if c.Name != "" && string([]byte(c.Name)[0]) == "&" {
 		isIconCode = true
@@ -0,0 +1,23 @@
+package icon_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &icon.I{Name: "star"}},
+		{"size-dark-inactive", &icon.I{Name: "star", SizePX: 36, Dark: true, Inactive: true}},
+		{"class-override", &icon.I{Name: "fa-star", ClassOverride: []string{"fa", "fa-star"}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
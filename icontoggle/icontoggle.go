@@ -4,27 +4,42 @@ import (
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/bind"
 	"github.com/vecty-material/material/icon"
 	"github.com/vecty-material/material/material/icontoggle"
 )
 
 // IT is a vecty-material icontoggle component.
+//
+// By default the user toggling the icontoggle toggles On before ChangeHandler
+// is called. If Controlled is set, On is left unchanged and the icontoggle
+// keeps showing it: ChangeHandler reports that the user asked for On to be
+// toggled, and the change only happens when the icontoggle is rendered with a
+// new value.
+//
+// If Bind is set, On is read from it on render, and the state the user asked
+// for is written to it on change.
 type IT struct {
 	*base.MDC
 	vecty.Core
-	Root          vecty.MarkupOrChild
-	ChangeHandler func(thisIT *IT, e *vecty.Event)
-	On            bool
-	Disabled      bool
-	OnIcon        *icon.I
-	OffIcon       *icon.I
+	Root          vecty.MarkupOrChild              `vecty:"prop"`
+	ChangeHandler func(thisIT *IT, e *vecty.Event) `vecty:"prop"`
+	On            bool                             `vecty:"prop"`
+	Disabled      bool                             `vecty:"prop"`
+	OnIcon        *icon.I                          `vecty:"prop"`
+	OffIcon       *icon.I                          `vecty:"prop"`
 	activeIcon    *icon.I
-	OnLabel       string
-	OffLabel      string
+	OnLabel       string     `vecty:"prop"`
+	OffLabel      string     `vecty:"prop"`
+	Controlled    bool       `vecty:"prop"`
+	Bind          *bind.Bool `vecty:"prop"`
+	bound         bind.Binding
 }
 
 // Render implements the vecty.Component interface.
 func (c *IT) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.On)
+
 	rootMarkup := base.MarkupOnly(c.Root)
 	if c.Root != nil && rootMarkup == nil {
 		// User supplied root element.
@@ -39,7 +54,7 @@ func (c *IT) Render() vecty.ComponentOrHTML {
 	return elem.Span(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		vecty.If(!c.On, c.OffIcon),
 		vecty.If(c.On, c.OnIcon),
@@ -98,18 +113,9 @@ func (c *IT) Apply(h *vecty.HTML) {
 			vecty.Attribute("aria-hidden", true),
 		),
 		&vecty.EventListener{
-			Name: "MDCIconToggle:change",
-			Listener: func(e *vecty.Event) {
-				c.On = !c.On
-				vecty.Rerender(c)
-			},
+			Name:     "MDCIconToggle:change",
+			Listener: c.onChange,
 		},
-		vecty.MarkupIf(c.ChangeHandler != nil,
-			&vecty.EventListener{
-				Name:     "MDCIconToggle:change",
-				Listener: c.wrapChangeHandler(),
-			},
-		),
 		vecty.Markup(markup...),
 		vecty.MarkupIf(c.On,
 			vecty.Class("mdc-icon-toggle--on"),
@@ -118,8 +124,23 @@ func (c *IT) Apply(h *vecty.HTML) {
 	c.MDC.RootElement = h
 }
 
-func (c *IT) wrapChangeHandler() func(e *vecty.Event) {
-	return func(e *vecty.Event) {
+// Unmount implements the vecty.Unmounter interface.
+func (c *IT) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
+}
+
+func (c *IT) onChange(e *vecty.Event) {
+	on := e.Get("detail").Get("isOn").Bool()
+	if !c.Controlled {
+		c.On = on
+		vecty.Rerender(c)
+	}
+	c.Bind.Set(on)
+	if c.ChangeHandler != nil {
 		c.ChangeHandler(c, e)
 	}
+	if c.Controlled {
+		c.MDC.Restore("on", c.On)
+	}
 }
@@ -0,0 +1,24 @@
+package icontoggle_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"off", &icontoggle.IT{OnIcon: &icon.I{Name: "favorite"}, OffIcon: &icon.I{Name: "favorite_border"}, OnLabel: "Remove", OffLabel: "Add"}},
+		{"on-disabled", &icontoggle.IT{OnIcon: &icon.I{Name: "favorite"}, OffIcon: &icon.I{Name: "favorite_border"}, OnLabel: "Remove", OffLabel: "Add", On: true, Disabled: true}},
+		{"class-override", &icontoggle.IT{OnIcon: &icon.I{Name: "fa-star", ClassOverride: []string{"fa", "fa-star"}}, OffIcon: &icon.I{Name: "fa-star-o", ClassOverride: []string{"fa", "fa-star-o"}}, OffLabel: "Star"}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
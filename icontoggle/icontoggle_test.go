@@ -0,0 +1,74 @@
+package icontoggle_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+	"github.com/vecty-material/material/materialtest"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	on, accept := false, false
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &icontoggle.IT{
+			OnIcon:     &icon.I{Name: "favorite"},
+			OffIcon:    &icon.I{Name: "favorite_border"},
+			On:         on,
+			Controlled: true,
+			ChangeHandler: func(this *icontoggle.IT, e *vecty.Event) {
+				if accept {
+					on = e.Get("detail").Get("isOn").Bool()
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	it := s.ByClass("mdc-icon-toggle")[0]
+
+	// Rendering the toggle with a new value changes it.
+	on = true
+	vecty.Rerender(a)
+	materialtest.AssertClass(t, it, "mdc-icon-toggle--on")
+	materialtest.AssertAria(t, it, "pressed", "true")
+
+	// A rejected change is undone to that value.
+	materialtest.Click(it)
+	materialtest.AssertClass(t, it, "mdc-icon-toggle--on")
+
+	accept = true
+	materialtest.Click(it)
+	materialtest.AssertNoClass(t, it, "mdc-icon-toggle--on")
+	if on {
+		t.Error("on = true after the user turned the toggle off")
+	}
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
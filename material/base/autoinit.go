@@ -0,0 +1,120 @@
+package base // import "github.com/vecty-material/material/material/base"
+
+import (
+	"errors"
+	"fmt"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs"
+)
+
+const (
+	// AutoInitAttr is the attribute AutoInit looks for. Its value is the
+	// MDCClassName of the component to start, for example:
+	//
+	//	<div class="mdc-checkbox" data-mdc-auto-init="MDCCheckbox">
+	AutoInitAttr = "data-mdc-auto-init"
+
+	// AutoInitStateAttr is set to "initialized" on elements that AutoInit has
+	// started a component for. Those elements are skipped by later calls.
+	AutoInitStateAttr = "data-mdc-auto-init-state"
+)
+
+var constructors = map[string]func() ComponentStartStopper{}
+
+// Register makes a component available to AutoInit under name, which should be
+// the MDCClassName of the component. Packages in this project register their
+// components when imported. Register panics if name is already registered.
+func Register(name string, newC func() ComponentStartStopper) {
+	if _, ok := constructors[name]; ok {
+		panic("base: Register called twice for " + name)
+	}
+	constructors[name] = newC
+}
+
+// New returns a new component of the type registered under name, or nil if
+// name is not registered.
+func New(name string) ComponentStartStopper {
+	newC, ok := constructors[name]
+	if !ok {
+		return nil
+	}
+	return newC()
+}
+
+// Registry holds the components started by AutoInit.
+type Registry struct {
+	components []ComponentStartStopper
+	byID       map[string]ComponentStartStopper
+}
+
+// ByID returns the component whose root element has the given id, or nil if
+// there is none.
+func (r *Registry) ByID(id string) ComponentStartStopper {
+	return r.byID[id]
+}
+
+// Components returns every component in r, in document order.
+func (r *Registry) Components() []ComponentStartStopper {
+	return r.components
+}
+
+// Stop stops every component in r and empties it. It returns the first error
+// encountered.
+func (r *Registry) Stop() error {
+	var first error
+	for _, c := range r.components {
+		e := c.Component().MDCState.RootElement
+		if !e.IsUndefined() && !e.IsNull() {
+			e.Call("removeAttribute", AutoInitStateAttr)
+		}
+		if err := c.Stop(); err != nil && first == nil {
+			first = err
+		}
+	}
+	r.components = nil
+	r.byID = map[string]ComponentStartStopper{}
+	return first
+}
+
+// AutoInit starts a component for root and every element inside it that has
+// an AutoInitAttr attribute, and returns them in a Registry. The component
+// type is looked up among those passed to Register. If any component fails to
+// start, the components started so far are stopped and the error is returned.
+func AutoInit(root js.Value) (r *Registry, err error) {
+	defer gojs.CatchException(&err)
+
+	if root.IsUndefined() || root.IsNull() {
+		return nil, errors.New("root is nil.")
+	}
+	r = &Registry{byID: map[string]ComponentStartStopper{}}
+	sel := "[" + AutoInitAttr + "]:not([" + AutoInitStateAttr + "])"
+	var elems []js.Value
+	if root.Call("matches", sel).Bool() {
+		elems = append(elems, root)
+	}
+	nodes := root.Call("querySelectorAll", sel)
+	for i := 0; i < nodes.Get("length").Int(); i++ {
+		elems = append(elems, nodes.Call("item", i))
+	}
+
+	for _, e := range elems {
+		name := e.Call("getAttribute", AutoInitAttr).String()
+		c := New(name)
+		if c == nil {
+			r.Stop()
+			return nil, fmt.Errorf("No component registered for %q.", name)
+		}
+		if err = c.Start(e); err != nil {
+			r.Stop()
+			return nil, err
+		}
+		e.Call("setAttribute", AutoInitStateAttr, "initialized")
+		r.components = append(r.components, c)
+		if id := e.Get("id").String(); id != "" {
+			r.byID[id] = c
+		}
+	}
+	return r, err
+}
@@ -0,0 +1,69 @@
+package base_test
+
+import (
+	"fmt"
+	"log"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/material/checkbox"
+	"github.com/vecty-material/material/material/internal/mdctest"
+
+	_ "github.com/vecty-material/material/material/formfield"
+)
+
+func ExampleAutoInit() {
+	// Set up HTML containing components marked for auto-init.
+	body := js.Global().Get("document").Get("body")
+	body.Set("innerHTML", `
+<div class="mdc-form-field" id="my-form-field" data-mdc-auto-init="MDCFormField">
+  <div class="mdc-checkbox" id="my-checkbox" data-mdc-auto-init="MDCCheckbox">
+    <input class="mdc-checkbox__native-control" type="checkbox">
+  </div>
+  <label>Label</label>
+</div>`)
+
+	// Start every marked component.
+	r, err := base.AutoInit(body)
+	if err != nil {
+		log.Fatalf("Unable to auto-init components: %v\n", err)
+	}
+	for _, c := range r.Components() {
+		fmt.Printf("%s\n", c.Component().Type)
+	}
+
+	// Look up a component by the ID of its root element.
+	if _, ok := r.ByID("my-checkbox").(*checkbox.CB); ok {
+		fmt.Println("my-checkbox is a *checkbox.CB")
+	}
+
+	// Components that were already started are skipped.
+	again, err := base.AutoInit(body)
+	if err != nil {
+		log.Fatalf("Unable to auto-init components: %v\n", err)
+	}
+	fmt.Printf("Started again: %v\n", len(again.Components()))
+
+	err = r.Stop()
+	if err != nil {
+		log.Fatalf("Unable to stop components: %v\n", err)
+	}
+	fmt.Printf("After Stop: %v\n", len(r.Components()))
+
+	// Output:
+	// MDCFormField
+	// MDCCheckbox
+	// my-checkbox is a *checkbox.CB
+	// Started again: 0
+	// After Stop: 0
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := mdctest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -1,6 +1,6 @@
 /*
 The base package contains code shared by implementations of material components
-for GopherJS.
+for GopherJS and WebAssembly.
 */
 package base // import "github.com/vecty-material/material/material/base"
 
@@ -17,6 +17,11 @@ type Component struct {
 	js.Value
 	*MDCState
 	Type ComponentType
+
+	// funcs holds the js.Func created by SetState for each property set to a
+	// Go func, so it can be released once the property is replaced or the
+	// component is stopped.
+	funcs map[string]js.Func
 }
 
 type MDCState struct {
@@ -58,16 +63,34 @@ func (c *Component) Stop() error {
 }
 
 func (c *Component) SetState(sm StateMap) *Component {
-	if sm != nil {
-		for k, v := range sm {
-			if v != nil {
-				c.Component().Set(k, v)
+	for k, v := range sm {
+		if v == nil {
+			continue
+		}
+		jv := gojs.Value(v)
+		c.Component().Set(k, jv)
+		if fn, ok := c.funcs[k]; ok {
+			fn.Release()
+			delete(c.funcs, k)
+		}
+		if fn, ok := jv.(js.Func); ok {
+			if c.funcs == nil {
+				c.funcs = map[string]js.Func{}
 			}
+			c.funcs[k] = fn
 		}
 	}
 	return c
 }
 
+// releaseFuncs releases the js.Funcs created by SetState.
+func (c *Component) releaseFuncs() {
+	for k, fn := range c.funcs {
+		fn.Release()
+		delete(c.funcs, k)
+	}
+}
+
 // Start takes a component implementation (c) and initializes it with an
 // HTMLElement (rootElem). Upon success err will be nil. If err is non-nil, it
 // will contain any error thrown while calling the underlying MDC object's
@@ -88,7 +111,11 @@ func (c *Component) SetState(sm StateMap) *Component {
 // There are two ways Start knows of to find the MDC class needed to start a
 // component. By default it uses values provided by the components in this
 // project via the ComponentType method. This default works in the general case
-// that the all-in-one MDC library is available under the global var "mdc".
+// that the all-in-one MDC library is available under the var "mdc" of the
+// window rootElem belongs to, or under the global var "mdc". Looking at
+// rootElem's window first allows starting components inside an emulated DOM,
+// such as a jsdom.JSDOM that loaded the library with LoadModule, without
+// assigning that DOM to the process globals.
 //
 // The second case, MDCClasser, is needed if the MDC code for your component is
 // elsewhere, for example if you are using the individual MDC component
@@ -129,7 +156,7 @@ func Start(c Componenter, rootElem js.Value) (err error) {
 		if CCaseName == "" || ClassName == "" {
 			return errors.New("Empty string in ComponentType")
 		}
-		mdcObject := js.Global().Get("mdc")
+		mdcObject := mdcLibrary(rootElem)
 		newMDCClassObj = mdcObject.Get(CCaseName).Get(ClassName)
 	}
 
@@ -142,7 +169,8 @@ func Start(c Componenter, rootElem js.Value) (err error) {
 }
 
 // Stop removes the component's association with its HTMLElement and cleans up
-// event listeners, etc. It then runs SetComponent(nil).
+// event listeners, etc. It releases the js.Funcs SetState created for Go func
+// properties, then runs SetComponent(nil).
 func Stop(c Componenter) (err error) {
 	defer gojs.CatchException(&err)
 
@@ -153,6 +181,22 @@ func Stop(c Componenter) (err error) {
 		return errors.New("GetComponent() returned nil.")
 	}
 	c.Component().Call("destroy")
+	c.Component().releaseFuncs()
 	c.Component().SetComponent(nil)
 	return err
 }
+
+// mdcLibrary returns the all-in-one MDC library object for rootElem. See the
+// "Finding The MDC Library" section of Start.
+func mdcLibrary(rootElem js.Value) js.Value {
+	doc := rootElem.Get("ownerDocument")
+	if !doc.IsUndefined() && !doc.IsNull() {
+		w := doc.Get("defaultView")
+		if !w.IsUndefined() && !w.IsNull() {
+			if mdc := w.Get("mdc"); !mdc.IsUndefined() {
+				return mdc
+			}
+		}
+	}
+	return js.Global().Get("mdc")
+}
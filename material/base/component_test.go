@@ -0,0 +1,21 @@
+package base_test
+
+import (
+	"testing"
+
+	"github.com/vecty-material/material/material/base"
+)
+
+func TestSetStateFunc(t *testing.T) {
+	var calls []string
+	c := &base.Component{}
+	c.SetState(base.StateMap{"onDone": func() { calls = append(calls, "a") }})
+	c.Call("onDone")
+	// Replacing the func releases the first one, so the second must be the
+	// one called.
+	c.SetState(base.StateMap{"onDone": func() { calls = append(calls, "b") }})
+	c.Call("onDone")
+	if len(calls) != 2 || calls[0] != "a" || calls[1] != "b" {
+		t.Errorf("calls = %v, want [a b]", calls)
+	}
+}
@@ -0,0 +1,137 @@
+package base // import "github.com/vecty-material/material/material/base"
+
+import (
+	"reflect"
+	"strings"
+)
+
+const (
+	// stateTagName is the struct tag that names the MDC property a field is
+	// bound to, for example `js:"checked"`.
+	stateTagName = "js"
+
+	// optionTagName is the struct tag used to opt a js tagged field out of
+	// some or all of the automatic state handling.
+	//
+	//   `mdc:"-"`         The field is computed or read-only on the MDC side,
+	//                     or is only passed to a method call. It is never
+	//                     written to the MDC instance, nor backed up.
+	//   `mdc:"writeonly"` The field can be set, but the MDC instance does not
+	//                     provide a usable getter for it. It is used to set
+	//                     initial state, but is not included in backups.
+	optionTagName = "mdc"
+)
+
+type stateField struct {
+	index     int
+	key       string
+	writeOnly bool
+}
+
+// StateMapOf returns a StateMap built from the `js:"..."` tags of the struct
+// that c points to. Unexported fields, untagged fields and fields tagged
+// `mdc:"-"` are skipped. Nil funcs, pointers, maps, slices and interfaces are
+// stored as nil so that SetState leaves the matching MDC property untouched.
+//
+// Components can use StateMapOf to implement the StateMapper interface:
+//
+//	func (c *CB) StateMap() base.StateMap {
+//		return base.StateMapOf(c)
+//	}
+func StateMapOf(c interface{}) StateMap {
+	return stateMap(c)
+}
+
+// Backup returns a StateMap of the current values of the MDC properties named
+// by the `js:"..."` tags of c, read from its MDC instance rather than from its
+// Go fields. Fields tagged `mdc:"writeonly"` are skipped since their values
+// cannot be read back, as are properties that are undefined. The result is
+// suitable for restoring state with Restore after restarting c.
+func Backup(c Componenter) StateMap {
+	sm := StateMap{}
+	t := reflect.TypeOf(c)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return sm
+	}
+	for _, f := range stateFields(t) {
+		if f.writeOnly {
+			continue
+		}
+		v := c.Component().Get(f.key)
+		if v.IsUndefined() {
+			continue
+		}
+		sm[f.key] = v
+	}
+	return sm
+}
+
+// Restore sets each value in sm on the MDC instance of c. It is a shorthand for
+// c.Component().SetState(sm).
+func Restore(c Componenter, sm StateMap) {
+	c.Component().SetState(sm)
+}
+
+func stateMap(c interface{}) StateMap {
+	sm := StateMap{}
+	v := reflect.ValueOf(c)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return sm
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return sm
+	}
+	for _, f := range stateFields(v.Type()) {
+		sm[f.key] = stateValue(v.Field(f.index))
+	}
+	return sm
+}
+
+// stateFields returns the fields of struct type t that take part in automatic
+// state handling.
+func stateFields(t reflect.Type) []stateField {
+	var fields []stateField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			// Unexported.
+			continue
+		}
+		key := sf.Tag.Get(stateTagName)
+		if key == "" || key == "-" {
+			continue
+		}
+		f := stateField{index: i, key: key}
+		skip := false
+		for _, opt := range strings.Split(sf.Tag.Get(optionTagName), ",") {
+			switch opt {
+			case "-":
+				skip = true
+			case "writeonly":
+				f.writeOnly = true
+			}
+		}
+		if skip {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func stateValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Func, reflect.Ptr, reflect.Map, reflect.Slice,
+		reflect.Interface, reflect.Chan:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	return v.Interface()
+}
@@ -0,0 +1,143 @@
+package base_test
+
+import (
+	"reflect"
+	"syscall/js"
+	"testing"
+
+	"github.com/vecty-material/material/material/base"
+)
+
+type state struct {
+	Checked    bool              `js:"checked"`
+	Value      string            `js:"value"`
+	Computed   bool              `js:"computed" mdc:"-"`
+	Initial    int               `js:"initial" mdc:"writeonly"`
+	Both       string            `js:"both" mdc:"writeonly,-"`
+	Skipped    string            `js:"-"`
+	OnChange   func()            `js:"onChange"`
+	Target     *state            `js:"target"`
+	Options    map[string]string `js:"options"`
+	Items      []string          `js:"items"`
+	Any        interface{}       `js:"any"`
+	Untagged   string
+	unexported bool `js:"unexported"`
+}
+
+func TestStateMapOf(t *testing.T) {
+	f := func() {}
+	tests := []struct {
+		name string
+		c    interface{}
+		want base.StateMap
+	}{
+		{
+			name: "zero",
+			c:    &state{},
+			want: base.StateMap{
+				"checked":  false,
+				"value":    "",
+				"initial":  0,
+				"onChange": nil,
+				"target":   nil,
+				"options":  nil,
+				"items":    nil,
+				"any":      nil,
+			},
+		},
+		{
+			name: "set",
+			c: &state{
+				Checked:    true,
+				Value:      "v",
+				Computed:   true,
+				Initial:    1,
+				Both:       "b",
+				Skipped:    "s",
+				Target:     &state{},
+				Items:      []string{},
+				Any:        "a",
+				Untagged:   "u",
+				unexported: true,
+			},
+			want: base.StateMap{
+				"checked":  true,
+				"value":    "v",
+				"initial":  1,
+				"onChange": nil,
+				"target":   &state{},
+				"options":  nil,
+				"items":    []string{},
+				"any":      "a",
+			},
+		},
+		{
+			name: "value",
+			c:    state{Value: "v"},
+			want: base.StateMap{
+				"checked":  false,
+				"value":    "v",
+				"initial":  0,
+				"onChange": nil,
+				"target":   nil,
+				"options":  nil,
+				"items":    nil,
+				"any":      nil,
+			},
+		},
+		{name: "nil", c: (*state)(nil), want: base.StateMap{}},
+		{name: "non-struct", c: 1, want: base.StateMap{}},
+	}
+	for _, tt := range tests {
+		got := base.StateMapOf(tt.c)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: StateMapOf() = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+
+	// Non-nil funcs cannot be compared with reflect.DeepEqual.
+	got := base.StateMapOf(&state{OnChange: f})
+	if _, ok := got["onChange"].(func()); !ok {
+		t.Errorf("StateMapOf()[%q] = %#v, want the func", "onChange",
+			got["onChange"])
+	}
+}
+
+// backupComponent keeps state only on its MDC instance, so that Backup can be
+// told apart from StateMapOf.
+type backupComponent struct {
+	mdc     base.Component
+	Checked bool   `js:"checked"`
+	Value   string `js:"value"`
+	Initial int    `js:"initial" mdc:"writeonly"`
+	Missing string `js:"missing"`
+}
+
+func (c *backupComponent) Component() *base.Component {
+	return c.mdc.Component()
+}
+
+func TestBackup(t *testing.T) {
+	c := &backupComponent{}
+	c.Component().SetState(base.StateMap{
+		"checked": true,
+		"value":   "on",
+		"initial": 1,
+	})
+	got := base.Backup(c)
+	if len(got) != 2 {
+		t.Fatalf("Backup() = %v, want only checked and value", got)
+	}
+	if v, ok := got["checked"].(js.Value); !ok || !v.Bool() {
+		t.Errorf("Backup()[%q] = %v, want true", "checked", got["checked"])
+	}
+	if v, ok := got["value"].(js.Value); !ok || v.String() != "on" {
+		t.Errorf("Backup()[%q] = %v, want %q", "value", got["value"], "on")
+	}
+
+	restored := &backupComponent{}
+	base.Restore(restored, got)
+	if !restored.Component().Get("checked").Bool() {
+		t.Error("Restore(Backup()) did not restore checked")
+	}
+}
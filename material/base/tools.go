@@ -4,18 +4,22 @@ import (
 	"syscall/js"
 
 	"github.com/vecty-material/material/gojs"
-	"github.com/vecty-material/material/gojs/jsdom"
 )
 
+// DefineSetGet defines the property key on the MDC instance of c with the
+// provided setter and getter. Each of them can be a js.Value holding a JS
+// function, nil, or one of the Go func types accepted by gojs.Value.
 func DefineSetGet(c Componenter, key string,
 	setter interface{}, getter interface{}) (err error) {
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
+	desc := map[string]interface{}{}
+	if setter != nil {
+		desc["set"] = gojs.Value(setter)
+	}
+	if getter != nil {
+		desc["get"] = gojs.Value(getter)
+	}
 	js.Global().Get("Object").Call("defineProperty",
-		c, key,
-		jsdom.M{
-			"set": setter,
-			"get": getter,
-		},
-	)
+		c.Component().Value, key, desc)
 	return err
 }
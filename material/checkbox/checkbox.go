@@ -25,6 +25,12 @@ func New() *CB {
 	return c
 }
 
+func init() {
+	base.Register("MDCCheckbox", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *CB) Start(rootElem js.Value) error {
@@ -56,10 +62,5 @@ func (c *CB) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *CB) StateMap() base.StateMap {
-	return base.StateMap{
-		"checked":       c.Checked,
-		"indeterminate": c.Indeterminate,
-		"disabled":      c.Disabled,
-		"value":         c.Value,
-	}
+	return base.StateMapOf(c)
 }
@@ -7,7 +7,8 @@ import (
 )
 
 func (c *D) afterStart() (err error) {
-	proto := js.Global().Get("Object").Call("getPrototypeOf", c)
+	proto := js.Global().Get("Object").Call("getPrototypeOf",
+		c.Component().Value)
 	ogGetter := js.Global().Get("Object").Call("getOwnPropertyDescriptor",
 		proto, "open").Get("get")
 	return base.DefineSetGet(c, "open",
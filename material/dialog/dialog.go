@@ -25,6 +25,12 @@ func New() *D {
 	return c
 }
 
+func init() {
+	base.Register("MDCDialog", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *D) Start(rootElem js.Value) error {
@@ -71,9 +77,7 @@ func (c *D) Component() *base.Component {
 // same thing as StateMap(). This method is private because we cannot restore
 // state until after afterStart() is called.
 func (c *D) stateMap() base.StateMap {
-	return base.StateMap{
-		"open": c.Open,
-	}
+	return base.StateMapOf(c)
 }
 
 // setOpen shows the dialog. If the dialog is already open then setOpen is a
@@ -29,5 +29,21 @@ program. See: https://material.io/components/web/catalog/
 
   cb := checkbox.CB{}
   cb.Start(cbElem)
+
+Build Targets
+
+Every package in this project only uses syscall/js to talk to the browser, so
+programs can be built with GopherJS or with the standard Go WebAssembly target:
+
+  GOOS=js GOARCH=wasm go build -o main.wasm
+
+When using WebAssembly, load main.wasm with the wasm_exec.js support file that
+ships with Go instead of including the GopherJS output.
+
+Testing
+
+Code that uses components through the base.ComponentStartStopper interface
+can be unit tested with the fakes in package fake, which record calls to Start,
+Stop and component methods without needing a DOM or the MDC library.
 */
 package material // import "github.com/vecty-material/material/material"
@@ -0,0 +1,575 @@
+package fake
+
+import (
+	"errors"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/material/menu"
+)
+
+// Checkbox is a fake checkbox.CB.
+type Checkbox struct {
+	Recorder
+	Checked       bool   `js:"checked"`
+	Indeterminate bool   `js:"indeterminate"`
+	Disabled      bool   `js:"disabled"`
+	Value         string `js:"value"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Checkbox) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Checkbox) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Checkbox) Component() *base.Component {
+	return c.component("MDCCheckbox", "checkbox")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Checkbox) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Dialog is a fake dialog.D.
+type Dialog struct {
+	Recorder
+	// Open opens and closes the dialog component.
+	Open bool `js:"open"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Dialog) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Dialog) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Dialog) Component() *base.Component {
+	return c.component("MDCDialog", "dialog")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Dialog) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// FormField is a fake formfield.FF.
+type FormField struct {
+	Recorder
+	Input interface{} `js:"input"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *FormField) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *FormField) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *FormField) Component() *base.Component {
+	return c.component("MDCFormField", "formField")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *FormField) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// IconToggle is a fake icontoggle.IT.
+type IconToggle struct {
+	Recorder
+	On       bool `js:"on"`
+	Disabled bool `js:"disabled"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *IconToggle) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *IconToggle) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *IconToggle) Component() *base.Component {
+	return c.component("MDCIconToggle", "iconToggle")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *IconToggle) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// LinearProgress is a fake linearprogress.LP.
+type LinearProgress struct {
+	Recorder
+	Determinate bool    `js:"determinate"`
+	Reverse     bool    `js:"reverse"`
+	Progress    float64 `js:"progress"`
+	Buffer      float64 `js:"buffer"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *LinearProgress) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *LinearProgress) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *LinearProgress) Component() *base.Component {
+	return c.component("MDCLinearProgress", "linearProgress")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *LinearProgress) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Open records a call to Open.
+func (c *LinearProgress) Open() error {
+	c.record("Open")
+	return nil
+}
+
+// Close records a call to Close.
+func (c *LinearProgress) Close() error {
+	c.record("Close")
+	return nil
+}
+
+// GetBufferCache returns Buffer.
+func (c *LinearProgress) GetBufferCache() float64 {
+	return c.Buffer
+}
+
+// Menu is a fake menu.M.
+type Menu struct {
+	Recorder
+	// Open is the visible state of the menu component.
+	Open bool `js:"open"`
+
+	// QuickOpen controls whether the menu should open and close without
+	// animation. False uses animation, true does not.
+	QuickOpen bool `js:"quickOpen"`
+
+	anchorCorner  menu.Corner
+	anchorMargins menu.Margins
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Menu) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Menu) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Menu) Component() *base.Component {
+	return c.component("MDCMenu", "menu")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Menu) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// OpenFocus records a call to OpenFocus and sets Open to true.
+func (c *Menu) OpenFocus(index int) {
+	c.record("OpenFocus", index)
+	c.Open = true
+}
+
+// Items returns nil, a fake menu has no item elements.
+func (c *Menu) Items() []js.Value {
+	return nil
+}
+
+// ItemsContainer returns js.Undefined(), a fake menu has no elements.
+func (c *Menu) ItemsContainer() js.Value {
+	return js.Undefined()
+}
+
+// AnchorCorner returns the Corner last passed to SetAnchorCorner.
+func (c *Menu) AnchorCorner() menu.Corner {
+	return c.anchorCorner
+}
+
+// SetAnchorCorner records a call to SetAnchorCorner and stores corner.
+func (c *Menu) SetAnchorCorner(corner menu.Corner) {
+	c.record("SetAnchorCorner", corner)
+	c.anchorCorner = corner
+}
+
+// AnchorMargins returns a copy of the Margins last passed to
+// SetAnchorMargins.
+func (c *Menu) AnchorMargins() *menu.Margins {
+	ms := c.anchorMargins
+	return &ms
+}
+
+// SetAnchorMargins records a call to SetAnchorMargins and stores a copy of
+// ms.
+func (c *Menu) SetAnchorMargins(ms *menu.Margins) {
+	c.record("SetAnchorMargins", *ms)
+	c.anchorMargins = *ms
+}
+
+// PersistentDrawer is a fake persistentdrawer.PD.
+type PersistentDrawer struct {
+	Recorder
+	Open bool `js:"open"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *PersistentDrawer) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *PersistentDrawer) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *PersistentDrawer) Component() *base.Component {
+	return c.component("MDCPersistentDrawer", "drawer")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *PersistentDrawer) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Radio is a fake radio.R.
+type Radio struct {
+	Recorder
+	Checked  bool   `js:"checked"`
+	Disabled bool   `js:"disabled"`
+	Value    string `js:"value"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Radio) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Radio) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Radio) Component() *base.Component {
+	return c.component("MDCRadio", "radio")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Radio) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Ripple is a fake ripple.R.
+type Ripple struct {
+	Recorder
+	Unbounded bool `js:"unbounded"`
+	Disabled  bool `js:"disabled"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Ripple) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Ripple) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Ripple) Component() *base.Component {
+	return c.component("MDCRipple", "ripple")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Ripple) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Activate records a call to Activate.
+func (c *Ripple) Activate() error {
+	c.record("Activate")
+	return nil
+}
+
+// Deactivate records a call to Deactivate.
+func (c *Ripple) Deactivate() error {
+	c.record("Deactivate")
+	return nil
+}
+
+// Layout records a call to Layout.
+func (c *Ripple) Layout() error {
+	c.record("Layout")
+	return nil
+}
+
+// Select is a fake selection.S.
+type Select struct {
+	Recorder
+	SelectedIndex int  `js:"selectedIndex"`
+	Disabled      bool `js:"disabled"`
+
+	// OptionStrings are the ids, or text content, of the options the fake
+	// select has. They are used by SelectedString.
+	OptionStrings []string
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Select) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Select) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Select) Component() *base.Component {
+	return c.component("MDCSelect", "select")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Select) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// SelectedString returns the option string at SelectedIndex, or an empty
+// string when no option is selected.
+func (c *Select) SelectedString() string {
+	if c.SelectedIndex < 0 || c.SelectedIndex >= len(c.OptionStrings) {
+		return ""
+	}
+	return c.OptionStrings[c.SelectedIndex]
+}
+
+// SelectedElem returns js.Undefined(), a fake select has no elements.
+func (c *Select) SelectedElem() js.Value {
+	return js.Undefined()
+}
+
+// Options returns js.Undefined(), a fake select has no elements.
+func (c *Select) Options() js.Value {
+	return js.Undefined()
+}
+
+// Slider is a fake slider.S.
+type Slider struct {
+	Recorder
+	Value    float64 `js:"value"`
+	Min      float64 `js:"min"`
+	Max      float64 `js:"max"`
+	Step     float64 `js:"step"`
+	Disabled bool    `js:"disabled"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Slider) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Slider) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Slider) Component() *base.Component {
+	return c.component("MDCSlider", "slider")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Slider) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Layout records a call to Layout.
+func (c *Slider) Layout() error {
+	c.record("Layout")
+	return nil
+}
+
+// Snackbar is a fake snackbar.S.
+type Snackbar struct {
+	Recorder
+	DismissOnAction bool   `js:"dismissOnAction"`
+	Message         string `js:"message"`
+	Timeout         int    `js:"timeout"`
+	ActionHandler   func() `js:"actionHandler" mdc:"writeonly"`
+	ActionText      string `js:"actionText"`
+	MultiLine       bool   `js:"multiline"`
+	ActionOnBottom  bool   `js:"actionOnBottom"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Snackbar) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Snackbar) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Snackbar) Component() *base.Component {
+	return c.component("MDCSnackbar", "snackbar")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *Snackbar) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Show records a call to Show. Like snackbar.S it returns an error, and
+// records nothing, if Message is empty or ActionHandler is set without
+// ActionText. A zero Timeout is set to the default of 2750.
+func (c *Snackbar) Show() error {
+	if c.Message == "" {
+		return errors.New("Snackbar Message is empty.")
+	}
+	if c.ActionHandler != nil && c.ActionText == "" {
+		return errors.New(
+			"Snackbar has ActionHandler, but ActionText is empty.")
+	}
+	if c.Timeout == 0 {
+		c.Timeout = 2750
+	}
+	c.record("Show", c.Message)
+	return nil
+}
+
+// TemporaryDrawer is a fake temporarydrawer.TD.
+type TemporaryDrawer struct {
+	Recorder
+	Open bool `js:"open"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *TemporaryDrawer) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *TemporaryDrawer) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *TemporaryDrawer) Component() *base.Component {
+	return c.component("MDCTemporaryDrawer", "drawer")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *TemporaryDrawer) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// TextField is a fake textfield.TF.
+type TextField struct {
+	Recorder
+	Value      string `js:"value"`
+	Disabled   bool   `js:"disabled"`
+	Valid      bool   `js:"valid"`
+	Required   bool   `js:"required"`
+	HelperText string `js:"helperText"`
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *TextField) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *TextField) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *TextField) Component() *base.Component {
+	return c.component("MDCTextField", "textField")
+}
+
+// StateMap implements the base.StateMapper interface.
+func (c *TextField) StateMap() base.StateMap {
+	return base.StateMapOf(c)
+}
+
+// Layout records a call to Layout.
+func (c *TextField) Layout() error {
+	c.record("Layout")
+	return nil
+}
+
+// Toolbar is a fake toolbar.T.
+type Toolbar struct {
+	Recorder
+}
+
+// Start implements the base.ComponentStartStopper interface.
+func (c *Toolbar) Start(rootElem js.Value) error {
+	return c.start(c.Component(), rootElem)
+}
+
+// Stop implements the base.ComponentStartStopper interface.
+func (c *Toolbar) Stop() error {
+	return c.stop(c.Component())
+}
+
+// Component implements the base.Componenter interface.
+func (c *Toolbar) Component() *base.Component {
+	return c.component("MDCToolbar", "toolbar")
+}
+
+var (
+	_ base.ComponentStartStopper = &Checkbox{}
+	_ base.ComponentStartStopper = &Dialog{}
+	_ base.ComponentStartStopper = &FormField{}
+	_ base.ComponentStartStopper = &IconToggle{}
+	_ base.ComponentStartStopper = &LinearProgress{}
+	_ base.ComponentStartStopper = &Menu{}
+	_ base.ComponentStartStopper = &PersistentDrawer{}
+	_ base.ComponentStartStopper = &Radio{}
+	_ base.ComponentStartStopper = &Ripple{}
+	_ base.ComponentStartStopper = &Select{}
+	_ base.ComponentStartStopper = &Slider{}
+	_ base.ComponentStartStopper = &Snackbar{}
+	_ base.ComponentStartStopper = &TemporaryDrawer{}
+	_ base.ComponentStartStopper = &TextField{}
+	_ base.ComponentStartStopper = &Toolbar{}
+)
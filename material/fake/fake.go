@@ -0,0 +1,121 @@
+// fake provides stand-ins for the material components that run entirely in
+// Go, for unit testing code that uses material components.
+//
+// Each fake has the same exported fields as the component it replaces and
+// implements base.ComponentStartStopper, so it can be used wherever code
+// accepts a component through that interface. Fakes never call into
+// JavaScript: Start and the component's methods only record that they were
+// called and update the fake's fields the way the real component would. No
+// DOM, jsdom or MDC library is needed.
+//
+//	func TestSave(t *testing.T) {
+//		sb := &fake.Snackbar{}
+//		app := newApp(sb)
+//		app.Save()
+//		if sb.Called("Show") != 1 || sb.Message != "Saved." {
+//			t.Errorf("Save did not show a message: %v", sb.Calls)
+//		}
+//	}
+package fake // import "github.com/vecty-material/material/material/fake"
+
+import (
+	"syscall/js"
+
+	"github.com/vecty-material/material/material/base"
+)
+
+// Call is a method call recorded by a fake component.
+type Call struct {
+	// Method is the name of the method, for example "Start" or "Show".
+	Method string
+
+	// Args holds the arguments the method was called with.
+	Args []interface{}
+}
+
+// Recorder records the calls made to a fake component. Every fake embeds a
+// Recorder.
+type Recorder struct {
+	// Calls holds the calls made to the component, in the order they were
+	// made.
+	Calls []Call
+
+	// StartErr and StopErr, if set, are returned by Start and Stop to simulate
+	// a component that fails to start or stop. The failed call is still
+	// recorded.
+	StartErr error
+	StopErr  error
+
+	mdc *base.Component
+}
+
+// Called returns the number of times method was called.
+func (r *Recorder) Called(method string) int {
+	n := 0
+	for _, c := range r.Calls {
+		if c.Method == method {
+			n++
+		}
+	}
+	return n
+}
+
+// LastCall returns the most recent call to method, and false if method has not
+// been called.
+func (r *Recorder) LastCall(method string) (Call, bool) {
+	for i := len(r.Calls) - 1; i >= 0; i-- {
+		if r.Calls[i].Method == method {
+			return r.Calls[i], true
+		}
+	}
+	return Call{}, false
+}
+
+// Started reports whether the component has been started and not stopped
+// since.
+func (r *Recorder) Started() bool {
+	return r.mdc != nil && r.mdc.MDCState.Started
+}
+
+// Reset discards the recorded calls.
+func (r *Recorder) Reset() {
+	r.Calls = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.Calls = append(r.Calls, Call{Method: method, Args: args})
+}
+
+// component returns the base.Component of the fake, creating it on first use.
+// Its Value is never set, as there is no MDC instance behind a fake.
+func (r *Recorder) component(class, camelCase string) *base.Component {
+	if r.mdc == nil {
+		r.mdc = &base.Component{
+			MDCState: &base.MDCState{},
+			Type: base.ComponentType{
+				MDCClassName:     class,
+				MDCCamelCaseName: camelCase,
+			},
+		}
+	}
+	return r.mdc
+}
+
+func (r *Recorder) start(mdc *base.Component, rootElem js.Value) error {
+	r.record("Start", rootElem)
+	if r.StartErr != nil {
+		return r.StartErr
+	}
+	mdc.MDCState.Started = true
+	mdc.MDCState.RootElement = rootElem
+	return nil
+}
+
+func (r *Recorder) stop(mdc *base.Component) error {
+	r.record("Stop")
+	if r.StopErr != nil {
+		return r.StopErr
+	}
+	mdc.MDCState.Started = false
+	return nil
+}
@@ -0,0 +1,55 @@
+package fake_test
+
+import (
+	"fmt"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/material/fake"
+)
+
+// notifier is application code under test. It only knows its snackbar through
+// the fields and methods it uses.
+type notifier struct {
+	sb interface {
+		base.ComponentStartStopper
+		Show() error
+	}
+	message *string
+}
+
+func (n *notifier) Notify(msg string) error {
+	if !n.sb.Component().MDCState.Started {
+		if err := n.sb.Start(js.Undefined()); err != nil {
+			return err
+		}
+	}
+	*n.message = msg
+	return n.sb.Show()
+}
+
+func Example() {
+	// No DOM is set up, fakes do not need one.
+	sb := &fake.Snackbar{}
+	n := &notifier{sb: sb, message: &sb.Message}
+
+	n.Notify("Saved.")
+	n.Notify("Saved again.")
+	fmt.Println(sb.Started(), sb.Called("Start"), sb.Called("Show"))
+	last, _ := sb.LastCall("Show")
+	fmt.Println(last.Args[0], sb.Timeout)
+
+	fmt.Println(n.Notify(""))
+	sb.Stop()
+	for _, c := range sb.Calls {
+		fmt.Print(c.Method, " ")
+	}
+	fmt.Println()
+
+	// Output:
+	// true 1 2
+	// Saved again. 2750
+	// Snackbar Message is empty.
+	// Start Show Show Stop
+}
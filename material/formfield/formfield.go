@@ -21,6 +21,12 @@ func New() *FF {
 	return c
 }
 
+func init() {
+	base.Register("MDCFormField", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *FF) Start(rootElem js.Value) error {
@@ -52,7 +58,5 @@ func (c *FF) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *FF) StateMap() base.StateMap {
-	return base.StateMap{
-		"input": js.ValueOf(c).Get("Input"),
-	}
+	return base.StateMapOf(c)
 }
@@ -23,6 +23,12 @@ func New() *IT {
 	return c
 }
 
+func init() {
+	base.Register("MDCIconToggle", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *IT) Start(rootElem js.Value) error {
@@ -54,10 +60,7 @@ func (c *IT) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *IT) StateMap() base.StateMap {
-	return base.StateMap{
-		"on":       c.On,
-		"disabled": c.Disabled,
-	}
+	return base.StateMapOf(c)
 }
 
 // TODO: Wrap refreshToggleData
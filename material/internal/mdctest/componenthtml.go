@@ -1,81 +1,19 @@
 package mdctest
 
+// HTML returns markup suitable for starting a component of MDC class t. For
+// the classes that have a vecty-material component the markup is rendered
+// from that component, see Fixture. HTML panics if there is no markup for t.
 func HTML(t string) string {
+	if _, ok := fixtures[t]; ok {
+		html, err := Fixture(t)
+		if err != nil {
+			panic(err)
+		}
+		return html
+	}
+
+	// Hand-written markup for classes without a vecty-material component.
 	switch t {
-	case "MDCCheckbox":
-		return `
-<div class="mdc-checkbox">
-  <input class="mdc-checkbox__native-control" id="my-checkbox" type="checkbox">
-</div>`
-	case "MDCDialog":
-		return `
-<aside id="my-mdc-dialog"
-  class="mdc-dialog"
-  role="alertdialog"
-  aria-labelledby="my-mdc-dialog-label"
-  aria-describedby="my-mdc-dialog-description">
-  <div class="mdc-dialog__surface">
-    <header class="mdc-dialog__header">
-      <h2 id="my-mdc-dialog-label" class="mdc-dialog__header__title">
-        Dialog header
-      </h2>
-    </header>
-    <section id="my-mdc-dialog-description" class="mdc-dialog__body">
-      Dialog description
-    </section>
-    <footer class="mdc-dialog__footer">
-      <button type="button" class="mdc-button mdc-dialog__footer__button mdc-dialog__footer__button--cancel">Decline</button>
-      <button type="button" class="mdc-button mdc-dialog__footer__button mdc-dialog__footer__button--accept">Accept</button>
-    </footer>
-  </div>
-  <div class="mdc-dialog__backdrop"></div>
-</aside>`
-	case "MDCPersistentDrawer":
-		return `
-<aside class="mdc-drawer mdc-drawer--persistent mdc-typography">
-  <nav class="mdc-drawer__drawer">
-    <header class="mdc-drawer__header">
-      <div class="mdc-drawer__header-content">
-        Header here
-      </div>
-    </header>
-    <nav id="icon-with-text-demo" class="mdc-drawer__content mdc-list">
-      <a class="mdc-list-item mdc-list-item--activated" href="#">
-        <i class="material-icons mdc-list-item__graphic" aria-hidden="true">inbox</i>Inbox
-      </a>
-      <a class="mdc-list-item" href="#">
-        <i class="material-icons mdc-list-item__graphic" aria-hidden="true">star</i>Star
-      </a>
-    </nav>
-  </nav>
-</aside>`
-	case "MDCTemporaryDrawer":
-		return `
-<aside class="mdc-drawer mdc-drawer--temporary mdc-typography">
-  <nav class="mdc-drawer__drawer">
-    <header class="mdc-drawer__header">
-      <div class="mdc-drawer__header-content">
-        Header here
-      </div>
-    </header>
-    <nav id="icon-with-text-demo" class="mdc-drawer__content mdc-list">
-      <a class="mdc-list-item mdc-list-item--activated" href="#">
-        <i class="material-icons mdc-list-item__graphic" aria-hidden="true">inbox</i>Inbox
-      </a>
-      <a class="mdc-list-item" href="#">
-        <i class="material-icons mdc-list-item__graphic" aria-hidden="true">star</i>Star
-      </a>
-    </nav>
-  </nav>
-</aside>`
-	case "MDCFormField":
-		return `
-<div class="mdc-form-field">
-  <div class="mdc-checkbox">
-    <input class="mdc-checkbox__native-control" id="my-checkbox" type="checkbox">
-  </div>
-  <label for="my-checkbox" id="ff-child-label">Form Field Label</label>
-</div>`
 	case "MDCGridList":
 		return `
 <div class="mdc-grid-list">
@@ -90,14 +28,6 @@ func HTML(t string) string {
     </li>
   </ul>
 </div>`
-	case "MDCIconToggle":
-		return `
-<i class="mdc-icon-toggle material-icons" role="button" aria-pressed="false"
-   aria-label="Add to favorites" tabindex="0"
-   data-toggle-on='{"label": "Remove from favorites", "content": "favorite"}'
-   data-toggle-off='{"label": "Add to favorites", "content": "favorite_border"}'>
-  favorite_border
-</i>`
 	case "MDCLinearProgress":
 		return `
 <div role="progressbar" class="mdc-linear-progress">
@@ -110,39 +40,6 @@ func HTML(t string) string {
     <span class="mdc-linear-progress__bar-inner"></span>
   </div>
 </div>`
-	case "MDCMenu":
-		return `
-<div class="mdc-menu" style="position: absolute;" tabindex="-1" id="demo-menu">
-  <ul class="mdc-menu__items mdc-list" role="menu" aria-hidden="true">
-    <li class="mdc-list-item" role="menuitem" tabindex="0">Back</li>
-    <li class="mdc-list-item" role="menuitem" tabindex="0">Forward</li>
-    <li class="mdc-list-item" role="menuitem" tabindex="0">Reload</li>
-    <li class="mdc-list-divider" role="separator"></li>
-    <span class="demo-menu__long-items">
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 1</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 2</li>
-    </span>
-    <span class="demo-menu__extra-long-items">
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 3</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 4</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 5</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 6</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 7</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 8</li>
-      <li class="mdc-list-item" role="menuitem" tabindex="0">Item 9</li>
-    </span>
-  </ul>
-</div>`
-	case "MDCRadio":
-		return `
-<div class="mdc-radio">
-  <input class="mdc-radio__native-control" type="radio" id="radio-1" name="radios" checked>
-  <div class="mdc-radio__background">
-    <div class="mdc-radio__outer-circle"></div>
-    <div class="mdc-radio__inner-circle"></div>
-  </div>
-</div>
-<label id="radio-1-label" for="radio-1">Radio 1</label>`
 	case "MDCRipple":
 		return `
 <div>
@@ -240,16 +137,6 @@ func HTML(t string) string {
 <p class="mdc-text-field-helper-text mdc-text-field-helper-text--persistent mdc-text-field-helper-text--validation-msg"
 id="pw-validation-msg">Must be at least 8 characters long
 </p>`
-	case "MDCToolbar":
-		return `
-<header class="mdc-toolbar">
-  <div class="mdc-toolbar__row">
-    <section class="mdc-toolbar__section mdc-toolbar__section--align-start">
-      <a href="#" class="material-icons mdc-toolbar__menu-icon">menu</a>
-      <span class="mdc-toolbar__title">Title</span>
-    </section>
-  </div>
-</header>`
 	}
 
 	panic("Failed to get HTML for component type: " + t)
@@ -0,0 +1,210 @@
+package mdctest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/button"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/dialog"
+	"github.com/vecty-material/material/drawer"
+	"github.com/vecty-material/material/formfield"
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+	"github.com/vecty-material/material/menu"
+	"github.com/vecty-material/material/radio"
+	"github.com/vecty-material/material/ssr"
+	"github.com/vecty-material/material/toolbar"
+	"github.com/vecty-material/material/ul"
+)
+
+// fixtures returns, for each MDC class with a vecty-material component, a
+// component to render for HTML.
+var fixtures = map[string]func() vecty.ComponentOrHTML{
+	"MDCCheckbox": func() vecty.ComponentOrHTML {
+		return &checkbox.CB{Input: vecty.Markup(prop.ID("my-checkbox"))}
+	},
+	"MDCDialog": func() vecty.ComponentOrHTML {
+		return &dialog.D{
+			Root:      vecty.Markup(prop.ID("my-mdc-dialog")),
+			Role:      "alertdialog",
+			Header:    "Dialog header",
+			Body:      vecty.Text("Dialog description"),
+			CancelBtn: &button.B{Label: vecty.Text("Decline")},
+		}
+	},
+	"MDCFormField": func() vecty.ComponentOrHTML {
+		return &formfield.FF{
+			Label: "Form Field Label",
+			Input: &checkbox.CB{Input: vecty.Markup(prop.ID("my-checkbox"))},
+		}
+	},
+	"MDCIconToggle": func() vecty.ComponentOrHTML {
+		return &icontoggle.IT{
+			OnIcon:   &icon.I{Name: "favorite"},
+			OffIcon:  &icon.I{Name: "favorite_border"},
+			OnLabel:  "Remove from favorites",
+			OffLabel: "Add to favorites",
+		}
+	},
+	"MDCMenu": func() vecty.ComponentOrHTML {
+		items := []vecty.ComponentOrHTML{
+			&ul.Item{Primary: vecty.Text("Back")},
+			&ul.Item{Primary: vecty.Text("Forward")},
+			&ul.Item{Primary: vecty.Text("Reload")},
+			ul.ItemDivider(),
+		}
+		for i := 1; i <= 9; i++ {
+			items = append(items,
+				&ul.Item{Primary: vecty.Text(fmt.Sprintf("Item %d", i))})
+		}
+		return &menu.M{
+			Root: vecty.Markup(prop.ID("demo-menu")),
+			List: &ul.L{Items: items},
+		}
+	},
+	"MDCPersistentDrawer": func() vecty.ComponentOrHTML {
+		return drawerFixture(drawer.Persistent)
+	},
+	"MDCRadio": func() vecty.ComponentOrHTML {
+		return &radio.R{
+			Input:   vecty.Markup(prop.ID("radio-1")),
+			Name:    "radios",
+			Checked: true,
+		}
+	},
+	"MDCTemporaryDrawer": func() vecty.ComponentOrHTML {
+		return drawerFixture(drawer.Temporary)
+	},
+	"MDCToolbar": func() vecty.ComponentOrHTML {
+		return &toolbar.T{
+			SectionStart: vecty.List{
+				elem.Anchor(
+					vecty.Markup(
+						prop.Href("#"),
+						vecty.Class("material-icons",
+							"mdc-toolbar__menu-icon"),
+					),
+					vecty.Text("menu"),
+				),
+				toolbar.Title("Title", nil),
+			},
+		}
+	},
+}
+
+// requires lists, for each MDC class in fixtures, selectors for the elements
+// the material component depends on. The first selector matches the root
+// element.
+var requires = map[string][]string{
+	"MDCCheckbox": {".mdc-checkbox",
+		".mdc-checkbox__native-control", ".mdc-checkbox__background"},
+	"MDCDialog": {".mdc-dialog",
+		".mdc-dialog__surface", ".mdc-dialog__body",
+		".mdc-dialog__footer__button--accept",
+		".mdc-dialog__footer__button--cancel", ".mdc-dialog__backdrop"},
+	"MDCFormField": {".mdc-form-field",
+		"input", "label[for]"},
+	"MDCIconToggle": {".mdc-icon-toggle[role=button][data-icon-inner-selector]",
+		".material-icons"},
+	"MDCMenu": {".mdc-menu",
+		".mdc-menu__items", ".mdc-list-item[role=menuitem]"},
+	"MDCPersistentDrawer": {".mdc-drawer--persistent",
+		".mdc-drawer__drawer", ".mdc-drawer__content"},
+	"MDCRadio": {".mdc-radio",
+		".mdc-radio__native-control", ".mdc-radio__background"},
+	"MDCTemporaryDrawer": {".mdc-drawer--temporary",
+		".mdc-drawer__drawer", ".mdc-drawer__content"},
+	"MDCToolbar": {".mdc-toolbar",
+		".mdc-toolbar__row", ".mdc-toolbar__title"},
+}
+
+// Fixture renders the vecty-material component for MDC class t, the same way
+// it is rendered in a browser, and checks that the markup has the structure
+// the material component for t depends on. It returns an error if there is no
+// component for t or the structure is missing. A DOM must be available, see
+// Init.
+func Fixture(t string) (html string, err error) {
+	newC, ok := fixtures[t]
+	if !ok {
+		return "", fmt.Errorf("No vecty-material component for %s.", t)
+	}
+	html, err = ssr.RenderToString(newC())
+	if err != nil {
+		return "", err
+	}
+
+	defer gojs.CatchException(&err)
+	doc := js.Global().Get("document")
+	if doc.IsUndefined() {
+		return "", errors.New("No DOM available, call Init first.")
+	}
+	tmpl := doc.Call("createElement", "template")
+	tmpl.Set("innerHTML", html)
+	root := tmpl.Get("content").Get("firstElementChild")
+	if root.IsNull() {
+		return "", fmt.Errorf("%s: vecty-material rendered no element.", t)
+	}
+
+	// Remove the markers ssr adds, which a browser render does not have.
+	marked := root.Call("querySelectorAll",
+		"["+base.PrerenderTypeAttr+"]")
+	for _, e := range append([]js.Value{root}, nodeList(marked)...) {
+		e.Call("removeAttribute", base.PrerenderTypeAttr)
+		e.Call("removeAttribute", base.PrerenderIDAttr)
+	}
+
+	var missing []string
+	for i, sel := range requires[t] {
+		switch {
+		case i == 0 && !root.Call("matches", sel).Bool():
+			missing = append(missing, sel)
+		case i > 0 && root.Call("querySelector", sel).IsNull():
+			missing = append(missing, sel)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%s: vecty-material markup is missing %s.",
+			t, strings.Join(missing, ", "))
+	}
+	return root.Get("outerHTML").String(), err
+}
+
+func drawerFixture(typ drawer.Type) vecty.ComponentOrHTML {
+	link := func(ico, text string, activated bool) *ul.Item {
+		return &ul.Item{
+			Href:      "#",
+			Activated: activated,
+			Graphic:   &icon.I{Name: ico},
+			Primary:   vecty.Text(text),
+		}
+	}
+	return &drawer.D{
+		Type:   typ,
+		Root:   vecty.Markup(vecty.Class("mdc-typography")),
+		Header: elem.Div(vecty.Text("Header here")),
+		Content: &ul.L{
+			Root: vecty.Markup(prop.ID("icon-with-text-demo")),
+			Items: []vecty.ComponentOrHTML{
+				link("inbox", "Inbox", true),
+				link("star", "Star", false),
+			},
+		},
+	}
+}
+
+func nodeList(nodes js.Value) []js.Value {
+	l := make([]js.Value, nodes.Get("length").Int())
+	for i := range l {
+		l[i] = nodes.Call("item", i)
+	}
+	return l
+}
@@ -0,0 +1,32 @@
+package mdctest
+
+import (
+	"log"
+	"sort"
+	"testing"
+)
+
+// TestFixtures fails when a vecty-material component stops rendering the
+// structure its material component depends on.
+func TestFixtures(t *testing.T) {
+	var names []string
+	for name := range fixtures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := requires[name]; !ok {
+			t.Errorf("%s: no required structure listed", name)
+		}
+		if _, err := Fixture(name); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func init() {
+	err := Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -27,6 +27,12 @@ func New() *LP {
 	return c
 }
 
+func init() {
+	base.Register("MDCLinearProgress", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *LP) Start(rootElem js.Value) error {
@@ -71,28 +77,21 @@ func (c *LP) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *LP) StateMap() base.StateMap {
-	sm := base.StateMap{
-		"determinate": c.Determinate,
-		"reverse":     c.Reverse,
-		"progress":    c.Progress,
-		"buffer":      c.bufferCache,
-	}
-	if c.Component().Value.Get("progress").String() == "undefined" {
-		sm["progress"] = js.ValueOf(c).Get("Progress")
-	}
+	sm := base.StateMapOf(c)
+	sm["buffer"] = c.bufferCache
 	return sm
 }
 
 // Open opens the linearProgress component.
 func (lp *LP) Open() (err error) {
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	lp.Component().Call("open")
 	return err
 }
 
 // Close closes the linearProgress component.
 func (lp *LP) Close() (err error) {
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	lp.Component().Call("close")
 	return err
 }
@@ -10,7 +10,8 @@ import (
 // that variable as expected in Go.
 func (c *M) afterStart() error {
 	o := c.Component().Value
-	proto := js.Global().Get("Object").Call("getPrototypeOf", c)
+	proto := js.Global().Get("Object").Call("getPrototypeOf",
+		c.Component().Value)
 	ogSetter := js.Global().Get("Object").Call("getOwnPropertyDescriptor",
 		proto, "quickOpen").Get("set")
 	// Adds a getter for M.quickOpen.
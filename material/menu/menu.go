@@ -58,6 +58,12 @@ func New() *M {
 	return c
 }
 
+func init() {
+	base.Register("MDCMenu", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *M) Start(rootElem js.Value) error {
@@ -101,10 +107,7 @@ func (c *M) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *M) StateMap() base.StateMap {
-	return base.StateMap{
-		"open":      c.Open,
-		"quickOpen": c.QuickOpen,
-	}
+	return base.StateMapOf(c)
 }
 
 // OpenFocus opens the menu with an item at index given initial focus.
@@ -132,7 +135,7 @@ func (m *M) AnchorCorner() Corner {
 
 // AnchorCorner sets the Corner the menu is/will be attached to.
 func (m *M) SetAnchorCorner(c Corner) {
-	m.Component().Call("setAnchorCorner", c)
+	m.Component().Call("setAnchorCorner", int(c))
 }
 
 // AnchorMargins returns the distance from the anchor point that the menu
@@ -156,7 +159,7 @@ func (m *M) SetAnchorMargins(ms *Margins) {
 	if m.Component().Get("foundation_").IsUndefined() {
 		return
 	}
-	o := &jsdom.M{
+	o := jsdom.M{
 		"left":   ms.Left,
 		"right":  ms.Right,
 		"top":    ms.Top,
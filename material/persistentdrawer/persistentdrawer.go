@@ -21,6 +21,12 @@ func New() *PD {
 	return c
 }
 
+func init() {
+	base.Register("MDCPersistentDrawer", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *PD) Start(rootElem js.Value) error {
@@ -52,7 +58,5 @@ func (c *PD) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *PD) StateMap() base.StateMap {
-	return base.StateMap{
-		"open": c.Open,
-	}
+	return base.StateMapOf(c)
 }
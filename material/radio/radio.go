@@ -24,6 +24,12 @@ func New() *R {
 	return c
 }
 
+func init() {
+	base.Register("MDCRadio", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *R) Start(rootElem js.Value) error {
@@ -58,9 +64,5 @@ func (c *R) StateMap() base.StateMap {
 	if c.Value == "undefined" {
 		c.Value = ""
 	}
-	return base.StateMap{
-		"checked":  c.Checked,
-		"disabled": c.Disabled,
-		"value":    c.Value,
-	}
+	return base.StateMapOf(c)
 }
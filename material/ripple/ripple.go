@@ -24,6 +24,12 @@ func New() *R {
 	return c
 }
 
+func init() {
+	base.Register("MDCRipple", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *R) Start(rootElem js.Value) error {
@@ -53,11 +59,9 @@ func (c *R) Component() *base.Component {
 	return c.mdc.Component()
 }
 
+// StateMap implements the base.StateMapper interface.
 func (c *R) StateMap() base.StateMap {
-	return base.StateMap{
-		"unbounded": c.Unbounded,
-		"disabled":  c.Disabled,
-	}
+	return base.StateMapOf(c)
 }
 
 // Activate triggers an activation of the ripple (the first stage, which happens
@@ -65,7 +69,7 @@ func (c *R) StateMap() base.StateMap {
 // pointerdown event). It expands from the center.
 func (r *R) Activate() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	r.mdc.Call("activate")
 	return err
 }
@@ -75,7 +79,7 @@ func (r *R) Activate() error {
 // or a pointerup event). It expands from the center.
 func (r *R) Deactivate() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	r.mdc.Call("deactivate")
 	return err
 }
@@ -84,7 +88,7 @@ func (r *R) Deactivate() error {
 // if a ripple surface’s position or dimension is changed programmatically.
 func (r *R) Layout() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	r.mdc.Call("layout")
 	return err
 }
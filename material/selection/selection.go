@@ -23,6 +23,12 @@ func New() *S {
 	return c
 }
 
+func init() {
+	base.Register("MDCSelect", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *S) Start(rootElem js.Value) error {
@@ -54,10 +60,7 @@ func (c *S) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *S) StateMap() base.StateMap {
-	return base.StateMap{
-		"selectedIndex": c.SelectedIndex,
-		"disabled":      c.Disabled,
-	}
+	return base.StateMapOf(c)
 }
 
 // SelectedString returns the id of the currently selected option. If no id is present
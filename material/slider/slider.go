@@ -44,6 +44,12 @@ func New() *S {
 	return c
 }
 
+func init() {
+	base.Register("MDCSlider", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *S) Start(rootElem js.Value) error {
@@ -75,26 +81,7 @@ func (c *S) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *S) StateMap() base.StateMap {
-	sm := base.StateMap{
-		"value":    c.Value,
-		"min":      c.Min,
-		"max":      c.Max,
-		"step":     c.Step,
-		"disabled": c.Disabled,
-	}
-	if c.Component().Value.Get("value").String() == "undefined" {
-		sm["value"] = js.ValueOf(c).Get("Value")
-	}
-	if c.Component().Value.Get("min").String() == "undefined" {
-		sm["min"] = js.ValueOf(c).Get("Min")
-	}
-	if c.Component().Value.Get("max").String() == "undefined" {
-		sm["max"] = js.ValueOf(c).Get("Max")
-	}
-	if c.Component().Value.Get("step").String() == "undefined" {
-		sm["step"] = js.ValueOf(c).Get("Step")
-	}
-	return sm
+	return base.StateMapOf(c)
 }
 
 // Layout recomputes the dimensions and re-lays out the component. This should
@@ -102,7 +89,7 @@ func (c *S) StateMap() base.StateMap {
 // elements change programmatically (it is called automatically on resize).
 func (s *S) Layout() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	s.mdc.Call("layout")
 	return err
 }
@@ -34,7 +34,7 @@ type S struct {
 
 	// The function to execute when the action is clicked.
 	// Optional.
-	ActionHandler func() `js:"actionHandler"`
+	ActionHandler func() `js:"actionHandler" mdc:"writeonly"`
 
 	// The text to display for the action button.
 	// Required if actionHandler is set.
@@ -42,7 +42,7 @@ type S struct {
 
 	// Whether to show the snackbar with space for multiple lines of text.
 	// Default is false.
-	MultiLine bool `js:"multiline"`
+	MultiLine bool `js:"multiline" mdc:"-"`
 
 	// Whether to show the action below the multiple lines of text.
 	// Optional, applies when multiline is true. Default is false.
@@ -56,6 +56,12 @@ func New() *S {
 	return c
 }
 
+func init() {
+	base.Register("MDCSnackbar", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *S) Start(rootElem js.Value) error {
@@ -87,28 +93,10 @@ func (c *S) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *S) StateMap() base.StateMap {
-	print()
-	sm := base.StateMap{
-		"dismissOnAction": c.DismissOnAction,
-		"message":         c.Message,
-		"timeout":         c.Timeout,
-		"actionHandler":   c.ActionHandler,
-		"actionText":      c.ActionText,
-		"actionOnBottom":  c.ActionOnBottom,
-	}
-	if c.Component().Value.Get("message").String() == "undefined" {
-		sm["message"] = js.ValueOf(c).Get("Message")
-	}
+	sm := base.StateMapOf(c)
 	if c.Component().Value.Get("timeout").String() == "undefined" {
 		sm["timeout"] = 2750
 	}
-	if c.Component().Get("actionHandler").String() == "undefined" {
-		c.ActionHandler = nil
-		sm["actionHandler"] = nil
-	}
-	if c.Component().Value.Get("actionText").String() == "undefined" {
-		sm["actionText"] = js.ValueOf(c).Get("ActionText")
-	}
 	return sm
 }
 
@@ -117,7 +105,7 @@ func (c *S) StateMap() base.StateMap {
 // config requirements look at documentation for S.
 func (c *S) Show() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	if c.Message == "" {
 		return errors.New("Snackbar Message is empty.")
 	}
@@ -137,7 +125,7 @@ func (c *S) Show() error {
 		data["actionHandler"] = c.ActionHandler
 		data["actionText"] = c.ActionText
 	}
-	c.mdc.Call("show", data)
+	c.mdc.Call("show", gojs.Value(data))
 	c.isNew = false
 	return err
 }
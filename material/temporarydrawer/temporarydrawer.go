@@ -21,6 +21,12 @@ func New() *TD {
 	return c
 }
 
+func init() {
+	base.Register("MDCTemporaryDrawer", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *TD) Start(rootElem js.Value) error {
@@ -52,8 +58,5 @@ func (c *TD) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *TD) StateMap() base.StateMap {
-	sm := base.StateMap{
-		"open": c.Open,
-	}
-	return sm
+	return base.StateMapOf(c)
 }
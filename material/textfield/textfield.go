@@ -38,6 +38,12 @@ func New() *TF {
 	return c
 }
 
+func init() {
+	base.Register("MDCTextField", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *TF) Start(rootElem js.Value) error {
@@ -69,26 +75,13 @@ func (c *TF) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *TF) StateMap() base.StateMap {
-	sm := base.StateMap{
-		"value":      c.Value,
-		"disabled":   c.Disabled,
-		"valid":      c.Value,
-		"required":   c.Required,
-		"helperText": c.HelperText,
-	}
-	if c.Component().Value.Get("value").String() == "undefined" {
-		sm["value"] = js.ValueOf(c).Get("Value")
-	}
-	if c.Component().Value.Get("helperText").String() == "undefined" {
-		sm["helperText"] = js.ValueOf(c).Get("HelperText")
-	}
-	return sm
+	return base.StateMapOf(c)
 }
 
 // Layout adjusts the dimensions and positions for all sub-elements.
 func (tf *TF) Layout() error {
 	var err error
-	gojs.CatchException(&err)
+	defer gojs.CatchException(&err)
 	tf.mdc.Call("layout")
 	return err
 }
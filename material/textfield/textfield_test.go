@@ -0,0 +1,15 @@
+package textfield_test
+
+import (
+	"testing"
+
+	"github.com/vecty-material/material/material/textfield"
+)
+
+func TestStateMapValid(t *testing.T) {
+	// valid used to hold Value.
+	c := &textfield.TF{Value: "value", Valid: true}
+	if got := c.StateMap()["valid"]; got != true {
+		t.Errorf("StateMap()[%q] = %#v, want true", "valid", got)
+	}
+}
@@ -21,6 +21,12 @@ func New() *T {
 	return c
 }
 
+func init() {
+	base.Register("MDCToolbar", func() base.ComponentStartStopper {
+		return New()
+	})
+}
+
 // Start initializes the component with an existing HTMLElement, rootElem. Start
 // should only be used on a newly created component, or after calling Stop.
 func (c *T) Start(rootElem js.Value) error {
@@ -52,5 +58,5 @@ func (c *T) Component() *base.Component {
 
 // StateMap implements the base.StateMapper interface.
 func (c *T) StateMap() base.StateMap {
-	return base.StateMap{}
+	return base.StateMapOf(c)
 }
@@ -0,0 +1,120 @@
+package materialtest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/a11y"
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/material/base"
+)
+
+// AssertClass reports an error if e does not have every class in classes.
+func AssertClass(t testing.TB, e js.Value, classes ...string) {
+	t.Helper()
+	for _, c := range classes {
+		if !e.Get("classList").Call("contains", c).Bool() {
+			t.Errorf("%s: missing class %q", describe(e), c)
+		}
+	}
+}
+
+// AssertNoClass reports an error if e has any class in classes.
+func AssertNoClass(t testing.TB, e js.Value, classes ...string) {
+	t.Helper()
+	for _, c := range classes {
+		if e.Get("classList").Call("contains", c).Bool() {
+			t.Errorf("%s: unexpected class %q", describe(e), c)
+		}
+	}
+}
+
+// AssertAttr reports an error if the attribute name of e is not want.
+func AssertAttr(t testing.TB, e js.Value, name, want string) {
+	t.Helper()
+	got := e.Call("getAttribute", name)
+	if got.IsNull() {
+		t.Errorf("%s: missing attribute %s, want %q", describe(e), name, want)
+		return
+	}
+	if got.String() != want {
+		t.Errorf("%s: attribute %s is %q, want %q", describe(e), name,
+			got.String(), want)
+	}
+}
+
+// AssertNoAttr reports an error if e has the attribute name.
+func AssertNoAttr(t testing.TB, e js.Value, name string) {
+	t.Helper()
+	if e.Call("hasAttribute", name).Bool() {
+		t.Errorf("%s: unexpected attribute %s", describe(e), name)
+	}
+}
+
+// AssertAria reports an error if the ARIA attribute "aria-"+name of e is not
+// want.
+func AssertAria(t testing.TB, e js.Value, name, want string) {
+	t.Helper()
+	AssertAttr(t, e, "aria-"+name, want)
+}
+
+// AssertProp reports an error if the DOM property name of e is not want. want
+// can be a bool, a number or a string.
+func AssertProp(t testing.TB, e js.Value, name string, want interface{}) {
+	t.Helper()
+	got := gojs.GoValue(e.Get(name))
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("%s: property %s is %v, want %v", describe(e), name, got,
+			want)
+	}
+}
+
+// AssertState reports an error if the Go-side state of c, as returned by
+// base.StateMapOf, differs from want for any key in want.
+func AssertState(t testing.TB, c base.Componenter, want base.StateMap) {
+	t.Helper()
+	got := base.StateMapOf(c)
+	for k, w := range want {
+		g, ok := got[k]
+		if !ok {
+			t.Errorf("%s: no state %q", c.Component().Type, k)
+			continue
+		}
+		if !reflect.DeepEqual(g, w) {
+			t.Errorf("%s: state %q is %v, want %v", c.Component().Type, k,
+				g, w)
+		}
+	}
+}
+
+// AssertAccessible reports an error for each accessibility issue a11y.Check
+// finds in e and the elements inside it.
+func AssertAccessible(t testing.TB, e js.Value) {
+	t.Helper()
+	issues, err := a11y.Check(e)
+	if err != nil {
+		t.Errorf("%s: unable to check accessibility: %v", describe(e), err)
+		return
+	}
+	for _, i := range issues {
+		t.Error(i)
+	}
+}
+
+func describe(e js.Value) string {
+	if e.IsNull() || e.IsUndefined() {
+		return "<nil>"
+	}
+	s := "<" + strings.ToLower(e.Get("tagName").String())
+	if id := e.Get("id").String(); id != "" {
+		s += "#" + id
+	}
+	if c := e.Get("className").String(); c != "" {
+		s += "." + strings.Join(strings.Fields(c), ".")
+	}
+	return s + ">"
+}
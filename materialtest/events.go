@@ -0,0 +1,78 @@
+package materialtest
+
+import (
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs/jsdom"
+)
+
+// Click simulates a mouse click on e. Like in a browser, clicking a checkbox
+// or radio input changes its checked state and fires a change event.
+func Click(e js.Value) {
+	dispatch(e, "MouseEvent", "mousedown", nil)
+	dispatch(e, "MouseEvent", "mouseup", nil)
+	e.Call("click")
+}
+
+// PressKey simulates pressing and releasing key on e, firing keydown,
+// keypress and keyup events. key is a KeyboardEvent.key value such as "Enter",
+// "Escape", "ArrowDown" or "a".
+func PressKey(e js.Value, key string) {
+	init := jsdom.M{"key": key}
+	if code, ok := keyCodes[key]; ok {
+		init["keyCode"] = code
+	}
+	dispatch(e, "KeyboardEvent", "keydown", init)
+	dispatch(e, "KeyboardEvent", "keypress", init)
+	dispatch(e, "KeyboardEvent", "keyup", init)
+}
+
+// Input sets the value of e and fires an input event, as if the user typed
+// value.
+func Input(e js.Value, value string) {
+	e.Set("value", value)
+	dispatch(e, "Event", "input", nil)
+}
+
+// Change fires a change event on e.
+func Change(e js.Value) {
+	dispatch(e, "Event", "change", nil)
+}
+
+// Focus focuses e.
+func Focus(e js.Value) {
+	e.Call("focus")
+}
+
+// Blur removes focus from e.
+func Blur(e js.Value) {
+	e.Call("blur")
+}
+
+// Dispatch fires a CustomEvent of type typ on e with the given detail, for
+// example to simulate MDC events such as "MDCMenu:selected".
+func Dispatch(e js.Value, typ string, detail jsdom.M) {
+	dispatch(e, "CustomEvent", typ, jsdom.M{"detail": detail})
+}
+
+var keyCodes = map[string]int{
+	"Backspace":  8,
+	"Tab":        9,
+	"Enter":      13,
+	"Escape":     27,
+	" ":          32,
+	"ArrowLeft":  37,
+	"ArrowUp":    38,
+	"ArrowRight": 39,
+	"ArrowDown":  40,
+}
+
+func dispatch(e js.Value, class, typ string, init jsdom.M) {
+	if init == nil {
+		init = jsdom.M{}
+	}
+	init["bubbles"] = true
+	init["cancelable"] = true
+	view := e.Get("ownerDocument").Get("defaultView")
+	e.Call("dispatchEvent", view.Get(class).New(typ, init))
+}
@@ -0,0 +1,176 @@
+package materialtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/gojs/jsdom"
+	"github.com/vecty-material/material/ssr"
+)
+
+// UpdateEnv is the environment variable that makes Golden write golden files
+// instead of comparing against them when it is set to a true value, such as
+// "1".
+const UpdateEnv = "MATERIALTEST_UPDATE"
+
+// voidElements are the elements that never have children or an end tag.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// RenderHTML renders c to normalized HTML, which is stable across renders and
+// easy to diff:
+//
+//   - Every element and text node is on its own line, indented by two spaces
+//     per level.
+//   - Attributes are sorted by name, and classes by value.
+//   - Whitespace in text is collapsed, and whitespace-only text is dropped.
+//
+// Components are rendered with ssr.RenderToString, so MDC components are not
+// started and the state of form controls is reflected into attributes. The
+// attributes ssr adds to component root elements are removed.
+func RenderHTML(c vecty.ComponentOrHTML) (string, error) {
+	html, err := ssr.RenderToString(c)
+	if err != nil {
+		return "", err
+	}
+	dom, err := jsdom.New("", nil)
+	if err != nil {
+		return "", err
+	}
+	defer dom.Close()
+	f, err := parse(dom, html)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	for _, n := range list(f.Get("childNodes")) {
+		normalize(buf, n, 0)
+	}
+	return buf.String(), nil
+}
+
+// Golden renders c with RenderHTML and compares the result against the golden
+// file testdata/<name>.golden. When the UpdateEnv environment variable is set
+// the golden file is written instead.
+func Golden(t testing.TB, name string, c vecty.ComponentOrHTML) {
+	t.Helper()
+	got, err := RenderHTML(c)
+	if err != nil {
+		t.Fatalf("%s: unable to render: %v", name, err)
+	}
+	path := filepath.Join("testdata", name+".golden")
+	if update() {
+		err = os.MkdirAll("testdata", 0755)
+		if err == nil {
+			err = ioutil.WriteFile(path, []byte(got), 0644)
+		}
+		if err != nil {
+			t.Fatalf("%s: unable to update golden file: %v", name, err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%s: unable to read golden file, run with %s=1 to "+
+			"create it: %v", name, UpdateEnv, err)
+	}
+	if got != string(want) {
+		t.Errorf("%s: markup does not match %s\n got:\n%s\nwant:\n%s",
+			name, path, got, want)
+	}
+}
+
+// update reports whether UpdateEnv is set to a true value.
+func update() bool {
+	u, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return u
+}
+
+// parse returns a document fragment of dom holding the nodes of html.
+func parse(dom jsdom.JSDOM, html string) (f js.Value, err error) {
+	defer gojs.CatchException(&err)
+	t := dom.Document().Call("createElement", "template")
+	t.Set("innerHTML", html)
+	return t.Get("content"), err
+}
+
+func normalize(buf *bytes.Buffer, n js.Value, depth int) {
+	indent := strings.Repeat("  ", depth)
+	switch n.Get("nodeType").Int() {
+	case 3: // Text
+		text := strings.Join(strings.Fields(n.Get("data").String()), " ")
+		if text != "" {
+			buf.WriteString(indent + text + "\n")
+		}
+		return
+	case 1: // Element
+	default:
+		return
+	}
+
+	tag := n.Get("localName").String()
+	buf.WriteString(indent + "<" + tag)
+	for _, a := range attributes(n) {
+		buf.WriteString(" " + a)
+	}
+	buf.WriteString(">")
+
+	var children []js.Value
+	for _, c := range list(n.Get("childNodes")) {
+		switch c.Get("nodeType").Int() {
+		case 1:
+			children = append(children, c)
+		case 3:
+			if strings.TrimSpace(c.Get("data").String()) != "" {
+				children = append(children, c)
+			}
+		}
+	}
+	switch {
+	case voidElements[tag]:
+		buf.WriteString("\n")
+		return
+	case len(children) == 0:
+		buf.WriteString("</" + tag + ">\n")
+		return
+	}
+	buf.WriteString("\n")
+	for _, c := range children {
+		normalize(buf, c, depth+1)
+	}
+	buf.WriteString(indent + "</" + tag + ">\n")
+}
+
+func attributes(e js.Value) []string {
+	var attrs []string
+	for _, a := range list(e.Get("attributes")) {
+		name := a.Get("name").String()
+		value := a.Get("value").String()
+		switch name {
+		case base.PrerenderIDAttr, base.PrerenderTypeAttr:
+			continue
+		case "class":
+			classes := strings.Fields(value)
+			sort.Strings(classes)
+			value = strings.Join(classes, " ")
+		}
+		value = strings.Replace(value, `"`, "&quot;", -1)
+		attrs = append(attrs, name+`="`+value+`"`)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
@@ -0,0 +1,156 @@
+// materialtest provides utilities for testing applications built with
+// vecty-material components.
+//
+// Tests run in Node, so materialtest emulates a browser DOM with jsdom and
+// loads the all-in-one MDC library. Components can then be mounted into the
+// emulated document, queried, interacted with and checked:
+//
+//	func TestSubscribe(t *testing.T) {
+//		s, err := materialtest.Mount(&checkbox.CB{
+//			Input: vecty.Markup(prop.ID("subscribe")),
+//		})
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		defer s.Unmount()
+//		input := s.ByID("subscribe")
+//		materialtest.Click(input)
+//		materialtest.AssertProp(t, input, "checked", true)
+//	}
+//
+// Call Init once, for example from TestMain or an init function, before
+// mounting any component.
+//
+// Golden compares the markup a component renders against a golden file in the
+// package's testdata directory. It does not need Init. Run the tests with the
+// environment variable MATERIALTEST_UPDATE=1 to write the golden files after an
+// intended change to the markup.
+//
+// Replay runs a script of interactions recorded in a running app with package
+// record against a mounted component, and checks the component states
+// recorded with it.
+package materialtest // import "github.com/vecty-material/material/materialtest"
+
+import (
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/gojs/jsdom"
+)
+
+const (
+	// MCWModule is the Node module Init loads the MDC library from.
+	MCWModule = "material-components-web/dist/material-components-web"
+
+	// MenuModule is the Node module InitMenu loads MDCMenu from.
+	MenuModule = "@material/menu/dist/mdc.menu"
+)
+
+var (
+	// Dom is the emulated DOM set up by Init or InitMenu.
+	Dom jsdom.JSDOM
+)
+
+// Init loads the MDC library, emulates a DOM and shims HTML5 form validation
+// into it.
+func Init() error {
+	err := LoadMDCModule()
+	if err != nil {
+		return err
+	}
+
+	Dom, err = EmulateDOM()
+	if err != nil {
+		return err
+	}
+
+	err = ShimHyperform()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InitMenu is like Init, but only loads the MDCMenu component.
+//
+// For some reason the material-components-web node module does not come with
+// MDCMenu, it only comes with an undocumented MDCSimpleMenu.
+func InitMenu() (err error) {
+	defer gojs.CatchException(&err)
+	mdc := js.Global().Get("Object").New()
+	mdc.Set("menu", js.Global().Call("require", MenuModule))
+	js.Global().Set("mdc", mdc)
+
+	Dom, err = EmulateDOM()
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// LoadMDCModule loads the all-in-one MDC library into the global var "mdc".
+func LoadMDCModule() (err error) {
+	defer gojs.CatchException(&err)
+	js.Global().Set("mdc", js.Global().Call("require", MCWModule))
+	return err
+}
+
+// ShimHyperform adds HTML5 form validation, which jsdom lacks, to the emulated
+// window.
+func ShimHyperform() error {
+	return shimHyperform(js.Global().Get("window"))
+}
+
+func shimHyperform(window js.Value) (err error) {
+	defer gojs.CatchException(&err)
+	js.Global().Call("require", "hyperform").Invoke(window)
+	return err
+}
+
+// NewDOM returns an emulated DOM with the MDC library and HTML5 form validation
+// loaded into its own window. Unlike EmulateDOM it leaves the process globals
+// alone, so any number of DOMs can be used at the same time. Material
+// components started on elements of the DOM use its copy of the MDC library.
+//
+// Vecty renders into the global document, so Mount cannot be used with a DOM
+// returned by NewDOM.
+func NewDOM() (dom jsdom.JSDOM, err error) {
+	dom, err = jsdom.New(`<html><body></body></html>`,
+		&jsdom.M{"pretendToBeVisual": true})
+	if err != nil {
+		return nil, err
+	}
+	err = dom.LoadModule(MCWModule)
+	if err != nil {
+		return nil, err
+	}
+	err = shimHyperform(dom.Window())
+	if err != nil {
+		return nil, err
+	}
+	return dom, nil
+}
+
+// EmulateDOM sets up a fake DOM in Node for "go test" with the js/wasm target,
+// or "gopherjs test". We emulate a browser dom since tests run in Node, and MDC
+// components need a dom element to attach to. It replaces the window, document
+// and related globals. This is not needed when running in a browser.
+func EmulateDOM() (dom jsdom.JSDOM, err error) {
+	dom, err = jsdom.New(``, &jsdom.M{"pretendToBeVisual": true})
+	if err != nil {
+		return nil, err
+	}
+	dom.SetHTML(`<html><body></body></html>`)
+	js.Global().Set("window", dom.Window())
+	js.Global().Set("document", dom.Window().Get("document"))
+	js.Global().Set("HTMLElement", dom.Window().Get("HTMLElement"))
+	raf := dom.Window().Get("requestAnimationFrame")
+	js.Global().Set("requestAnimationFrame", raf)
+	caf := dom.Window().Get("cancelAnimationFrame")
+	js.Global().Set("cancelAnimationFrame", caf)
+	gcs := dom.Window().Get("getComputedStyle")
+	js.Global().Set("getComputedStyle", gcs)
+	return dom, err
+}
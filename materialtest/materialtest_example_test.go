@@ -0,0 +1,49 @@
+package materialtest_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/formfield"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func Example() {
+	// Mount a checkbox inside a form field into the emulated DOM.
+	s, err := materialtest.Mount(&formfield.FF{
+		Label: "Subscribe",
+		Input: &checkbox.CB{
+			Input: vecty.Markup(prop.ID("subscribe")),
+		},
+	})
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+
+	// Find the input through its label and click it.
+	input := s.ByLabelText("Subscribe")
+	fmt.Printf("Found: %v\n", input.Get("id"))
+	fmt.Printf("Checked: %v\n", input.Get("checked"))
+	materialtest.Click(input)
+	fmt.Printf("Checked: %v\n", input.Get("checked"))
+	fmt.Printf("Checkboxes: %v\n", len(s.ByClass("mdc-checkbox")))
+
+	// Output:
+	// Found: subscribe
+	// Checked: false
+	// Checked: true
+	// Checkboxes: 1
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package materialtest_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/vecty-material/material/material/checkbox"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func ExampleNewDOM() {
+	// Create two independent emulated DOMs, which can be used at the same
+	// time.
+	for _, id := range []string{"first", "second"} {
+		dom, err := materialtest.NewDOM()
+		if err != nil {
+			log.Fatalf("Unable to create DOM: %v\n", err)
+		}
+		defer dom.Close()
+
+		// Start a checkbox in the DOM. It uses the DOM's own MDC library.
+		rootElem := dom.PopulateBody(`
+<div class="mdc-checkbox" id="` + id + `">
+  <input class="mdc-checkbox__native-control" type="checkbox">
+</div>`)
+		c := checkbox.New()
+		err = c.Start(rootElem)
+		if err != nil {
+			log.Fatalf("Unable to start component %s: %v\n",
+				c.Component().Type, err)
+		}
+		fmt.Printf("%s started in %v\n", c.Component().Type,
+			dom.Document().Get("body").Get("firstElementChild").Get("id"))
+
+		// Console output of the DOM's window is captured.
+		_, err = dom.Eval(`console.warn("hello from", "` + id + `")`)
+		if err != nil {
+			log.Fatalf("Unable to run script: %v\n", err)
+		}
+		for _, m := range dom.Console().Messages() {
+			fmt.Printf("[%s] %s\n", m.Level, m.Text)
+		}
+
+		err = c.Stop()
+		if err != nil {
+			log.Fatalf("Unable to stop component %s: %v\n",
+				c.Component().Type, err)
+		}
+	}
+
+	// Output:
+	// MDCCheckbox started in first
+	// [warn] hello from first
+	// MDCCheckbox started in second
+	// [warn] hello from second
+}
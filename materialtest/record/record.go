@@ -0,0 +1,303 @@
+// record captures user interactions with a running vecty-material app as a
+// Script, which materialtest.Replay can run against components mounted in an
+// emulated DOM. This turns a bug reproduced by clicking through an app into a
+// regression test:
+//
+//	r := record.Start(js.Global().Get("document").Get("body"))
+//	r.Expose("recordedScript")
+//
+// Interact with the app, then run recordedScript() in the browser console and
+// save the JSON it returns in the package's testdata directory. Call
+// Checkpoint from the app, for example in an event handler, to have the
+// replay check a component's state at that point.
+//
+// The record package does not depend on the testing package or on jsdom, so it
+// can be built into an app during development.
+package record // import "github.com/vecty-material/material/materialtest/record"
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/material/base"
+)
+
+// Actions of a Step.
+const (
+	// Click clicks Target.
+	Click = "click"
+
+	// Input sets the value of Target to Value and fires an input event.
+	Input = "input"
+
+	// Change fires a change event on Target.
+	Change = "change"
+
+	// Key presses the key Value, a KeyboardEvent.key value, on Target.
+	Key = "key"
+
+	// Focus focuses Target.
+	Focus = "focus"
+
+	// Blur removes focus from Target.
+	Blur = "blur"
+
+	// Event expects the MDC custom event Value, for example
+	// "MDCMenu:selected", to have been fired on Target as a result of the
+	// steps before it. It is checked, not dispatched, when replaying.
+	Event = "event"
+
+	// State expects the component named Component to have State.
+	State = "state"
+)
+
+// MDCEvents are the custom events a Recorder records as Event steps.
+var MDCEvents = []string{
+	"MDCDialog:accept",
+	"MDCDialog:cancel",
+	"MDCIconToggle:change",
+	"MDCMenu:cancel",
+	"MDCMenu:selected",
+	"MDCPersistentDrawer:close",
+	"MDCPersistentDrawer:open",
+	"MDCSelect:change",
+	"MDCSlider:change",
+	"MDCSlider:input",
+	"MDCSnackbar:hide",
+	"MDCSnackbar:show",
+	"MDCTemporaryDrawer:close",
+	"MDCTemporaryDrawer:open",
+}
+
+// Script is a recorded sequence of interactions. It is serialized with
+// encoding/json.
+type Script struct {
+	Steps []Step `json:"steps"`
+}
+
+// Step is a single interaction or expectation in a Script.
+type Step struct {
+	// Action is one of the constants Click, Input, Change, Key, Focus, Blur,
+	// Event or State.
+	Action string `json:"action"`
+
+	// Target is a CSS selector for the element the step applies to, relative
+	// to the recording root. An empty Target is the root itself.
+	Target string `json:"target,omitempty"`
+
+	// Value is the input value for Input, the key for Key and the event type
+	// for Event.
+	Value string `json:"value,omitempty"`
+
+	// Detail is the detail of an Event step's event, if it can be represented
+	// in JSON.
+	Detail map[string]interface{} `json:"detail,omitempty"`
+
+	// Component and State are the component name and expected state of a
+	// State step.
+	Component string        `json:"component,omitempty"`
+	State     base.StateMap `json:"state,omitempty"`
+}
+
+// Recorder records the interactions inside a root element.
+type Recorder struct {
+	root      js.Value
+	steps     []Step
+	listeners []listener
+
+	// labelControl is the control of a label that was just clicked. Its click,
+	// which the browser fires on the label's behalf, is not recorded.
+	labelControl js.Value
+}
+
+type listener struct {
+	typ string
+	fn  js.Func
+}
+
+// Start returns a Recorder that records the interactions with root and the
+// elements inside it until Stop is called.
+func Start(root js.Value) *Recorder {
+	r := &Recorder{root: root, labelControl: js.Null()}
+	r.listen("click", r.onClick)
+	r.listen("input", func(e js.Value) {
+		t := e.Get("target")
+		r.add(Step{Action: Input, Target: r.selector(t),
+			Value: t.Get("value").String()})
+	})
+	r.listen("change", func(e js.Value) {
+		if checkable(e.Get("target")) {
+			// Fired by the click that is already recorded.
+			return
+		}
+		r.add(Step{Action: Change, Target: r.selector(e.Get("target"))})
+	})
+	r.listen("keydown", func(e js.Value) {
+		r.add(Step{Action: Key, Target: r.selector(e.Get("target")),
+			Value: e.Get("key").String()})
+	})
+	r.listen("focusin", func(e js.Value) {
+		r.add(Step{Action: Focus, Target: r.selector(e.Get("target"))})
+	})
+	r.listen("focusout", func(e js.Value) {
+		r.add(Step{Action: Blur, Target: r.selector(e.Get("target"))})
+	})
+	for _, typ := range MDCEvents {
+		r.listen(typ, r.onMDCEvent)
+	}
+	return r
+}
+
+// Stop removes the Recorder's event listeners. The steps recorded so far are
+// still available from Script.
+func (r *Recorder) Stop() {
+	for _, l := range r.listeners {
+		r.root.Call("removeEventListener", l.typ, l.fn, true)
+		l.fn.Release()
+	}
+	r.listeners = nil
+}
+
+// Checkpoint records a State step with the current state of c, which the
+// replay checks. name identifies c in the components passed to
+// materialtest.Replay. If keys are given, only those state keys are recorded.
+func (r *Recorder) Checkpoint(name string, c base.Componenter, keys ...string) {
+	r.add(Step{Action: State, Component: name, State: StateOf(c, keys...)})
+}
+
+// Script returns the steps recorded so far.
+func (r *Recorder) Script() *Script {
+	return &Script{Steps: append([]Step(nil), r.steps...)}
+}
+
+// Expose makes the recorded script available to the browser console as the
+// global function name, which returns the script as JSON.
+func (r *Recorder) Expose(name string) {
+	js.Global().Set(name, js.FuncOf(
+		func(this js.Value, args []js.Value) interface{} {
+			b, err := json.MarshalIndent(r.Script(), "", "  ")
+			if err != nil {
+				return err.Error()
+			}
+			return string(b)
+		}))
+}
+
+// StateOf returns the current state of c for keys, or for every key of
+// base.StateMapOf(c) if no keys are given. Values are read from the MDC
+// instance when c is started, so they reflect changes made by user
+// interaction.
+func StateOf(c base.Componenter, keys ...string) base.StateMap {
+	goState := base.StateMapOf(c)
+	if len(keys) == 0 {
+		for k := range goState {
+			keys = append(keys, k)
+		}
+	}
+	mdc := c.Component()
+	started := mdc.MDCState != nil && mdc.MDCState.Started
+	sm := base.StateMap{}
+	for _, k := range keys {
+		switch {
+		case started:
+			sm[k] = gojs.GoValue(mdc.Get(k))
+		default:
+			sm[k] = goState[k]
+		}
+		if _, ok := sm[k].(js.Value); ok {
+			// Objects cannot be recorded.
+			delete(sm, k)
+		}
+	}
+	return sm
+}
+
+func (r *Recorder) listen(typ string, fn func(e js.Value)) {
+	f := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		fn(args[0])
+		return nil
+	})
+	r.root.Call("addEventListener", typ, f, true)
+	r.listeners = append(r.listeners, listener{typ: typ, fn: f})
+}
+
+func (r *Recorder) add(s Step) {
+	r.steps = append(r.steps, s)
+}
+
+func (r *Recorder) onClick(e js.Value) {
+	t := e.Get("target")
+	if !r.labelControl.IsNull() && t.Equal(r.labelControl) {
+		r.labelControl = js.Null()
+		return
+	}
+	r.labelControl = js.Null()
+	if label := t.Call("closest", "label"); !label.IsNull() {
+		if c := label.Get("control"); !c.IsUndefined() && !c.IsNull() &&
+			!c.Equal(t) {
+			r.labelControl = c
+		}
+	}
+	r.add(Step{Action: Click, Target: r.selector(t)})
+}
+
+func (r *Recorder) onMDCEvent(e js.Value) {
+	s := Step{Action: Event, Target: r.selector(e.Get("target")),
+		Value: e.Get("type").String()}
+	if d := e.Get("detail"); d.Type() == js.TypeObject {
+		s.Detail = detail(d)
+	}
+	r.add(s)
+}
+
+// detail returns d converted through JSON, or nil if d cannot be converted.
+func detail(d js.Value) (m map[string]interface{}) {
+	var err error
+	defer gojs.CatchException(&err)
+	s := js.Global().Get("JSON").Call("stringify", d)
+	if s.Type() != js.TypeString {
+		return nil
+	}
+	if json.Unmarshal([]byte(s.String()), &m) != nil {
+		return nil
+	}
+	return m
+}
+
+// selector returns a CSS selector for e, relative to the root. Elements with
+// an id are selected by it, others by their position below the closest
+// ancestor with an id, or the root.
+func (r *Recorder) selector(e js.Value) string {
+	var path []string
+	for !e.IsNull() && !e.IsUndefined() && !e.Equal(r.root) {
+		if id := e.Get("id").String(); id != "" {
+			path = append([]string{`[id="` +
+				strings.Replace(id, `"`, `\"`, -1) + `"]`}, path...)
+			return strings.Join(path, " > ")
+		}
+		n := 1
+		for s := e.Get("previousElementSibling"); !s.IsNull(); s = s.Get(
+			"previousElementSibling") {
+			n++
+		}
+		path = append([]string{strings.ToLower(e.Get("tagName").String()) +
+			":nth-child(" + strconv.Itoa(n) + ")"}, path...)
+		e = e.Get("parentElement")
+	}
+	if len(path) == 0 {
+		return ""
+	}
+	return ":scope > " + strings.Join(path, " > ")
+}
+
+func checkable(e js.Value) bool {
+	if strings.ToLower(e.Get("tagName").String()) != "input" {
+		return false
+	}
+	typ := e.Get("type").String()
+	return typ == "checkbox" || typ == "radio"
+}
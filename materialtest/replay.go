@@ -0,0 +1,137 @@
+package materialtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"syscall/js"
+
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/materialtest/record"
+)
+
+// ReplayEventTimeout is how long Replay waits for the event of a record.Event
+// step, as MDC fires some events asynchronously.
+var ReplayEventTimeout = time.Second
+
+// firedEvent is an MDC event seen by Replay.
+type firedEvent struct {
+	typ    string
+	target js.Value
+	seen   bool
+}
+
+// Replay runs the steps of script, usually recorded with package record,
+// against the component mounted in s. Interactions are simulated like the
+// functions Click, Input, PressKey etc. do. record.Event steps check that the
+// event was fired, and record.State steps check the state of the component
+// that components maps the step's Component name to. Replay returns an error
+// for the first step that cannot be run or whose check fails.
+func Replay(s *Screen, script *record.Script,
+	components map[string]base.Componenter) (err error) {
+	defer gojs.CatchException(&err)
+	if s == nil || s.root.IsNull() {
+		return errors.New("Nothing mounted.")
+	}
+
+	var fired []*firedEvent
+	types := map[string]bool{}
+	for _, st := range script.Steps {
+		if st.Action == record.Event {
+			types[st.Value] = true
+		}
+	}
+	for typ := range types {
+		f := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			fired = append(fired, &firedEvent{
+				typ:    args[0].Get("type").String(),
+				target: args[0].Get("target"),
+			})
+			return nil
+		})
+		s.root.Call("addEventListener", typ, f, true)
+		defer f.Release()
+		defer s.root.Call("removeEventListener", typ, f, true)
+	}
+
+	for i, st := range script.Steps {
+		if err := replayStep(s, st, components, &fired); err != nil {
+			return fmt.Errorf("step %d (%s %s): %v", i+1, st.Action,
+				st.Target+st.Component, err)
+		}
+	}
+	return err
+}
+
+func replayStep(s *Screen, st record.Step,
+	components map[string]base.Componenter, fired *[]*firedEvent) error {
+	if st.Action == record.State {
+		c, ok := components[st.Component]
+		if !ok {
+			return errors.New("no component with that name")
+		}
+		return compareState(c, st.State)
+	}
+
+	e := s.root
+	if st.Target != "" {
+		e = s.root.Call("querySelector", st.Target)
+		if e.IsNull() {
+			return errors.New("target not found")
+		}
+	}
+	switch st.Action {
+	case record.Click:
+		Click(e)
+	case record.Input:
+		Input(e, st.Value)
+	case record.Change:
+		Change(e)
+	case record.Key:
+		PressKey(e, st.Value)
+	case record.Focus:
+		Focus(e)
+	case record.Blur:
+		Blur(e)
+	case record.Event:
+		deadline := time.Now().Add(ReplayEventTimeout)
+		for {
+			for _, f := range *fired {
+				if !f.seen && f.typ == st.Value && f.target.Equal(e) {
+					f.seen = true
+					return nil
+				}
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("event %s was not fired", st.Value)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	default:
+		return errors.New("unknown action")
+	}
+	return nil
+}
+
+// compareState compares the current state of c to want. Both are compared in
+// their JSON form, as recorded scripts are.
+func compareState(c base.Componenter, want base.StateMap) error {
+	var keys []string
+	for k := range want {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	got := record.StateOf(c, keys...)
+	for _, k := range keys {
+		g, _ := json.Marshal(got[k])
+		w, _ := json.Marshal(want[k])
+		if string(g) != string(w) {
+			return fmt.Errorf("state %q is %s, want %s", k, g, w)
+		}
+	}
+	return nil
+}
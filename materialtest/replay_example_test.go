@@ -0,0 +1,55 @@
+package materialtest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/materialtest/record"
+)
+
+func ExampleReplay() {
+	// Record clicking a checkbox. In an app the recorder runs in the browser
+	// and the user does the clicking.
+	cb := &checkbox.CB{Input: vecty.Markup(prop.ID("terms"))}
+	s, err := materialtest.Mount(cb)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	r := record.Start(s.Root())
+	materialtest.Click(s.ByID("terms"))
+	r.Checkpoint("terms", cb.MDC.Component, "checked")
+	r.Stop()
+	s.Unmount()
+
+	b, err := json.Marshal(r.Script())
+	if err != nil {
+		log.Fatalf("Unable to encode script: %v\n", err)
+	}
+	fmt.Println(string(b))
+
+	// Replay the script against a newly mounted checkbox.
+	script := &record.Script{}
+	if err = json.Unmarshal(b, script); err != nil {
+		log.Fatalf("Unable to decode script: %v\n", err)
+	}
+	cb = &checkbox.CB{Input: vecty.Markup(prop.ID("terms"))}
+	s, err = materialtest.Mount(cb)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+	err = materialtest.Replay(s, script, map[string]base.Componenter{
+		"terms": cb.MDC.Component,
+	})
+	fmt.Printf("Replay error: %v\n", err)
+
+	// Output:
+	// {"steps":[{"action":"click","target":"[id=\"terms\"]"},{"action":"state","component":"terms","state":{"checked":true}}]}
+	// Replay error: <nil>
+}
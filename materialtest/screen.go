@@ -0,0 +1,210 @@
+package materialtest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/gojs"
+)
+
+// body wraps mounted components so they can be passed to vecty.RenderBody.
+type body struct {
+	vecty.Core
+	child vecty.ComponentOrHTML
+}
+
+// Render implements the vecty.Component interface.
+func (c *body) Render() vecty.ComponentOrHTML {
+	return elem.Body(c.child)
+}
+
+// Screen is a component mounted into the emulated document by Mount. Its query
+// methods only search inside the mounted component.
+type Screen struct {
+	root js.Value
+}
+
+// Mount renders c as the only child of the emulated document's body, which
+// also starts the MDC components it contains.
+func Mount(c vecty.ComponentOrHTML) (s *Screen, err error) {
+	defer gojs.CatchException(&err)
+	if js.Global().Get("document").IsUndefined() {
+		return nil, errors.New("No document, call Init first.")
+	}
+	vecty.RenderBody(&body{child: c})
+	root := js.Global().Get("document").Get("body").Get("firstElementChild")
+	if root.IsNull() {
+		return nil, errors.New("Component rendered no element.")
+	}
+	return &Screen{root: root}, err
+}
+
+// Unmount replaces the mounted component with an empty body.
+func (s *Screen) Unmount() {
+	vecty.RenderBody(&body{})
+	s.root = js.Null()
+}
+
+// Root returns the root element of the mounted component.
+func (s *Screen) Root() js.Value {
+	return s.root
+}
+
+// HTML returns the outer HTML of the mounted component.
+func (s *Screen) HTML() string {
+	return s.root.Get("outerHTML").String()
+}
+
+// Query returns the first element matching the CSS selector, including the
+// root element, or null if there is none.
+func (s *Screen) Query(selector string) js.Value {
+	all := s.QueryAll(selector)
+	if len(all) == 0 {
+		return js.Null()
+	}
+	return all[0]
+}
+
+// QueryAll returns the elements matching the CSS selector, including the root
+// element, in document order.
+func (s *Screen) QueryAll(selector string) []js.Value {
+	var elems []js.Value
+	if s.root.Call("matches", selector).Bool() {
+		elems = append(elems, s.root)
+	}
+	return append(elems, list(s.root.Call("querySelectorAll", selector))...)
+}
+
+// ByID returns the element with the given id, or null if there is none.
+func (s *Screen) ByID(id string) js.Value {
+	e := s.root.Get("ownerDocument").Call("getElementById", id)
+	if e.IsNull() || !s.root.Call("contains", e).Bool() {
+		return js.Null()
+	}
+	return e
+}
+
+// ByClass returns the elements that have every class in classes.
+func (s *Screen) ByClass(classes ...string) []js.Value {
+	sel := ""
+	for _, c := range classes {
+		sel += "." + escapeIdent(c)
+	}
+	return s.QueryAll(sel)
+}
+
+// ByRole returns the elements with the given role attribute. Elements with an
+// implicit role, such as a button element, are matched by their tag name.
+func (s *Screen) ByRole(role string) []js.Value {
+	sel := "[role=" + quote(role) + "]"
+	switch role {
+	case "button":
+		sel += `, button:not([role]), input[type="button"]:not([role])`
+	case "checkbox":
+		sel += `, input[type="checkbox"]:not([role])`
+	case "radio":
+		sel += `, input[type="radio"]:not([role])`
+	case "textbox":
+		sel += `, input[type="text"]:not([role]), textarea:not([role])`
+	case "link":
+		sel += `, a[href]:not([role])`
+	case "list":
+		sel += `, ul:not([role]), ol:not([role])`
+	case "listitem":
+		sel += `, li:not([role])`
+	case "dialog":
+		sel += `, dialog:not([role])`
+	}
+	return s.QueryAll(sel)
+}
+
+// ByLabelText returns the element labelled by text, or null if there is none.
+// An element is labelled by a label element whose "for" attribute references
+// it or which contains it, by its aria-label attribute, or by the elements its
+// aria-labelledby attribute references.
+func (s *Screen) ByLabelText(text string) js.Value {
+	for _, e := range s.QueryAll("[aria-label], [aria-labelledby]") {
+		if e.Call("getAttribute", "aria-label").String() == text {
+			return e
+		}
+		by := e.Call("getAttribute", "aria-labelledby")
+		if by.IsNull() {
+			continue
+		}
+		for _, id := range strings.Fields(by.String()) {
+			l := e.Get("ownerDocument").Call("getElementById", id)
+			if !l.IsNull() && textOf(l) == text {
+				return e
+			}
+		}
+	}
+	for _, l := range s.QueryAll("label") {
+		if textOf(l) != text {
+			continue
+		}
+		if !l.Get("control").IsUndefined() && !l.Get("control").IsNull() {
+			return l.Get("control")
+		}
+		if f := l.Call("getAttribute", "for"); !f.IsNull() {
+			return l.Get("ownerDocument").Call("getElementById", f.String())
+		}
+	}
+	return js.Null()
+}
+
+// ByText returns the elements whose own trimmed text content equals text.
+func (s *Screen) ByText(text string) []js.Value {
+	var elems []js.Value
+	for _, e := range s.QueryAll("*") {
+		if textOf(e) == text && e.Get("childElementCount").Int() == 0 {
+			elems = append(elems, e)
+		}
+	}
+	return elems
+}
+
+// escapeIdent escapes s for use as a CSS identifier, such as a class name in
+// a selector, like CSS.escape does.
+func escapeIdent(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == 0:
+			b.WriteRune('\uFFFD')
+		case r < 0x20 || r == 0x7f,
+			r >= '0' && r <= '9' && (i == 0 || i == 1 && s[0] == '-'):
+			fmt.Fprintf(&b, "\\%x ", r)
+		case r >= 0x80, r == '-', r == '_',
+			r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// quote returns s as a double quoted CSS string, for use as an attribute value
+// in a selector.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\a `).
+		Replace(s) + `"`
+}
+
+func textOf(e js.Value) string {
+	return strings.TrimSpace(e.Get("textContent").String())
+}
+
+func list(nodes js.Value) []js.Value {
+	l := make([]js.Value, nodes.Get("length").Int())
+	for i := range l {
+		l[i] = nodes.Call("item", i)
+	}
+	return l
+}
@@ -0,0 +1,33 @@
+package materialtest_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+)
+
+func TestScreenSelectorEscaping(t *testing.T) {
+	s, err := materialtest.Mount(elem.Div(
+		elem.Span(vecty.Markup(prop.ID("vm:1"))),
+		elem.Span(vecty.Markup(vecty.Class("a.b", "2col"))),
+		elem.Span(vecty.Markup(vecty.Attribute("role", `say "hi"`))),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Unmount()
+
+	if s.ByID("vm:1").IsNull() {
+		t.Errorf("ByID(%q) found nothing", "vm:1")
+	}
+	if got := len(s.ByClass("a.b", "2col")); got != 1 {
+		t.Errorf("ByClass(%q, %q) found %d elements, want 1", "a.b", "2col",
+			got)
+	}
+	if got := len(s.ByRole(`say "hi"`)); got != 1 {
+		t.Errorf("ByRole(%q) found %d elements, want 1", `say "hi"`, got)
+	}
+}
@@ -1,56 +1,81 @@
 package menu
 
 import (
-	"syscall/js"
-
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
 	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/bind"
+	"github.com/vecty-material/material/button"
 	"github.com/vecty-material/material/material/menu"
 	"github.com/vecty-material/material/ul"
 )
 
-// M is a vecty-material menu component.
+// M is a vecty-material menu component. When AnchorElement is set, it is marked
+// as the control that opens the menu with aria-controls and aria-haspopup.
+//
+// By default the menu closing on select or cancel sets Open to false before
+// OnSelect or OnCancel is called. If Controlled is set, Open is left unchanged
+// and the menu keeps showing it: the callbacks report that the user asked for
+// the menu to close, and it only closes when it is rendered with Open set to
+// false.
+//
+// If Bind is set, Open is read from it on render, and false is written to it
+// when the menu closes.
 type M struct {
 	*menu.M
 	*base.MDC
 	vecty.Core
-	Root       vecty.MarkupOrChild
+	Root       vecty.MarkupOrChild `vecty:"prop"`
 	menuAnchor *vecty.HTML
+	autoID     string
+
+	// marked and markedID record the anchor button markAnchor last added its
+	// attributes to, so they are only added once.
+	marked   *button.B
+	markedID string
 
 	// Open is the visible state of the menu component.
-	Open bool `js:"open"`
+	Open bool `js:"open" vecty:"prop"`
 
 	// QuickOpen controls whether the menu should open and close without
 	// animation. False uses animation, true does not.
-	QuickOpen bool `js:"quickOpen"`
+	QuickOpen bool `js:"quickOpen" vecty:"prop"`
 
 	// List is a HTMLUListElement containing the menu's items.
-	List vecty.ComponentOrHTML
+	List vecty.ComponentOrHTML `vecty:"prop"`
 
 	// Set AnchorElement to embed the menu component inside an HTMLElement from
 	// which the element will be anchored.
-	AnchorElement vecty.ComponentOrHTML
+	AnchorElement vecty.ComponentOrHTML `vecty:"prop"`
 
 	// Define OnSelect to handle "MDCMenu:selected" events. item is the
 	// menu item that was selected.
-	OnSelect func(index int, item vecty.ComponentOrHTML, e *vecty.Event)
+	OnSelect func(index int, item vecty.ComponentOrHTML, e *vecty.Event) `vecty:"prop"`
 
 	// Define OnCancel to handle "MDCMenu:selected" events. item is the
 	// menu item that was selected.
-	OnCancel func(e *vecty.Event)
+	OnCancel func(e *vecty.Event) `vecty:"prop"`
+
+	// Controlled leaves Open unchanged when the menu closes, see M.
+	Controlled bool `vecty:"prop"`
+
+	Bind  *bind.Bool `vecty:"prop"`
+	bound bind.Binding
 }
 
 // Render implements the vecty.Component interface.
 func (c *M) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Open)
+
 	rootMarkup := base.MarkupOnly(c.Root)
 	if c.Root != nil && rootMarkup == nil {
 		// User supplied root element.
 		return elem.Div(c.Root)
 	}
 
-	// TODO: Make initial values work in material package
-	open := js.InternalObject(c).Get("Open").Bool()
+	open := c.Open
 
 	listMarkup := []vecty.Applyer{
 		vecty.Class("mdc-menu__items"),
@@ -85,10 +110,17 @@ func (c *M) Render() vecty.ComponentOrHTML {
 		}
 	}
 
+	var id string
+	if c.AnchorElement != nil {
+		id = c.id(rootMarkup)
+		c.markAnchor(id)
+	}
+
 	menuElement := elem.Div(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			vecty.MarkupIf(id != "", prop.ID(id)),
 		),
 		c.List,
 	)
@@ -112,13 +144,10 @@ func (c *M) Apply(h *vecty.HTML) {
 		c.MDC = &base.MDC{}
 		fallthrough
 	case c.M == nil, c.MDC.Component == nil:
-		// TODO: Make initial values work in material package
-		open := js.InternalObject(c).Get("Open").Bool()
-		quickOpen := js.InternalObject(c).Get("QuickOpen").Bool()
 		c.M = menu.New()
 		c.MDC.Component = c.M
-		c.Open = open
-		c.QuickOpen = quickOpen
+		c.M.Open = c.Open
+		c.M.QuickOpen = c.QuickOpen
 	}
 
 	vecty.Markup(
@@ -145,7 +174,55 @@ func (c *M) Apply(h *vecty.HTML) {
 	c.MDC.RootElement = h
 }
 
+// id returns the ID set in the menu's root markup, or one allocated by
+// base.AutoID if there is none.
+func (c *M) id(rootMarkup *vecty.MarkupList) string {
+	if rootMarkup != nil {
+		if id := applyer.FindID(rootMarkup); id != "" {
+			return id
+		}
+	}
+	return base.AutoID(&c.autoID)
+}
+
+// markAnchor marks c.AnchorElement as the control that opens the menu with the
+// root element ID id.
+func (c *M) markAnchor(id string) {
+	markup := vecty.Markup(
+		vecty.Attribute("aria-haspopup", "true"),
+		vecty.Attribute("aria-controls", id),
+	)
+	switch t := c.AnchorElement.(type) {
+	case *button.B:
+		if t == c.marked && id == c.markedID {
+			return
+		}
+		mu := base.MarkupOnly(t.Root)
+		if t.Root != nil && mu == nil {
+			// User supplied root element.
+			return
+		}
+		t.Root = vecty.Markup(
+			vecty.MarkupIf(mu != nil, mu),
+			markup,
+		)
+		c.marked, c.markedID = t, id
+	case *vecty.HTML:
+		markup.Apply(t)
+	}
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (c *M) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
+}
+
 func (c *M) onSelect(e *vecty.Event) {
+	if !c.Controlled {
+		c.Open = false
+	}
+	c.Bind.Set(false)
 	if c.OnSelect != nil {
 		var item vecty.ComponentOrHTML
 		i := e.Get("detail").Get("index").Int()
@@ -158,12 +235,25 @@ func (c *M) onSelect(e *vecty.Event) {
 		}
 		c.OnSelect(i, item, e)
 	}
+	c.restore()
 }
 
 func (c *M) onCancel(e *vecty.Event) {
+	if !c.Controlled {
+		c.Open = false
+	}
+	c.Bind.Set(false)
 	if c.OnCancel != nil {
 		c.OnCancel(e)
 	}
+	c.restore()
+}
+
+// restore reopens a controlled menu that closed while Open is still set.
+func (c *M) restore() {
+	if c.Controlled && c.Open {
+		c.MDC.Restore("open", true)
+	}
 }
 
 // TODO: Figure out how to use or replicate MDC behavior
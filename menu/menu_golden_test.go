@@ -0,0 +1,25 @@
+package menu_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/button"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/menu"
+	"github.com/vecty-material/material/ul"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"closed", &menu.M{List: &ul.L{Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("One")}, &ul.Item{Primary: vecty.Text("Two")}}}}},
+		{"open", &menu.M{Open: true, List: &ul.L{Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("One")}, &ul.Item{Primary: vecty.Text("Two")}}}}},
+		{"anchored", &menu.M{AnchorElement: &button.B{Label: vecty.Text("Menu")}, List: &ul.L{Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("One")}, &ul.Item{Primary: vecty.Text("Two")}}}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -0,0 +1,77 @@
+package menu_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/menu"
+	"github.com/vecty-material/material/ul"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	open, accept := false, false
+	a := &app{}
+	a.render = func() vecty.ComponentOrHTML {
+		return &menu.M{
+			// The MDC menu is not loaded by materialtest.Init.
+			Root: vecty.Markup(applyer.CSSOnly()),
+			Open: open,
+			List: &ul.L{Items: []vecty.ComponentOrHTML{
+				&ul.Item{Primary: vecty.Text("One")},
+			}},
+			Controlled: true,
+			OnCancel: func(e *vecty.Event) {
+				if accept {
+					open = false
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	m := s.ByClass("mdc-menu")[0]
+	items := s.ByClass("mdc-menu__items")[0]
+
+	// Rendering the menu with a new value opens it.
+	open = true
+	vecty.Rerender(a)
+	materialtest.AssertClass(t, m, "mdc-menu--open")
+	materialtest.AssertNoAttr(t, items, "aria-hidden")
+
+	// A rejected close leaves it open.
+	materialtest.Dispatch(m, "MDCMenu:cancel", nil)
+	materialtest.AssertClass(t, m, "mdc-menu--open")
+
+	accept = true
+	materialtest.Dispatch(m, "MDCMenu:cancel", nil)
+	materialtest.AssertNoClass(t, m, "mdc-menu--open")
+	materialtest.AssertAria(t, items, "hidden", "true")
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
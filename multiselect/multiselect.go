@@ -0,0 +1,368 @@
+// multiselect implements a field selecting any number of options from a menu of
+// checkboxes, showing the selected options as removable chips.
+package multiselect // import "github.com/vecty-material/material/multiselect"
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/menu"
+	"github.com/vecty-material/material/ul"
+)
+
+// Option is an option of a multi-select.
+type Option struct {
+	Value    string
+	Label    string
+	Disabled bool
+}
+
+// S is a vecty-material multi-select component. Clicking the field, or
+// pressing Enter, Space or the down arrow key while it has focus, opens a menu
+// with a checkbox for each option. The selected options are shown as chips in
+// the field, in the order of Options, and can be removed with the chip's
+// remove icon. While the menu is open, the arrow keys move through the shown
+// options and Enter or Space checks or unchecks the highlighted one. Focus
+// stays in the field, which points to the highlighted option with
+// aria-activedescendant. The menu closes on Escape, or when focus leaves the
+// component.
+//
+// If Searchable is set, the menu starts with a search field hiding the options
+// whose label does not contain the search text, for long lists of options.
+//
+// The menu and chips are rendered CSS only, the MDC menu component would take
+// focus from the search field.
+//
+// By default the user selecting or removing an option updates Selected before
+// OnChange is called with the new selection. If Controlled is set, Selected is
+// left unchanged: OnChange reports the selection the user asked for, and it
+// only changes when the multi-select is rendered with a new Selected.
+type S struct {
+	vecty.Core
+	Root       vecty.MarkupOrChild              `vecty:"prop"`
+	Label      string                           `vecty:"prop"`
+	Options    []Option                         `vecty:"prop"`
+	Selected   []string                         `vecty:"prop"`
+	Searchable bool                             `vecty:"prop"`
+	OnChange   func(this *S, selected []string) `vecty:"prop"`
+	Controlled bool                             `vecty:"prop"`
+	autoID     string
+	root       *vecty.HTML
+	open       bool
+	query      string
+
+	// highlighted is the index in Options of the option highlighted with the
+	// arrow keys, or -1 if there is none.
+	highlighted int
+}
+
+// Render implements the vecty.Component interface.
+func (c *S) Render() vecty.ComponentOrHTML {
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	id := base.AutoID(&c.autoID)
+	if !c.open || c.highlighted >= len(c.Options) {
+		c.highlighted = -1
+	}
+	selected := c.selected()
+	chips := []vecty.MarkupOrChild{
+		vecty.Markup(vecty.Class("mdc-chip-set")),
+	}
+	items := []vecty.ComponentOrHTML{}
+	if c.Searchable {
+		items = append(items, c.search(id))
+	}
+	for _, o := range c.Options {
+		if selected[o.Value] {
+			chips = append(chips, c.chip(o))
+		}
+	}
+	for _, i := range c.shown() {
+		items = append(items, c.item(id, i, selected[c.Options[i].Value]))
+	}
+
+	c.root = elem.Div(
+		vecty.Markup(
+			vecty.Class("mdc-menu-anchor"),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			&vecty.EventListener{Name: "focusout", Listener: c.onFocusOut},
+			event.KeyDown(c.onKeyDown),
+		),
+		elem.Div(
+			vecty.Markup(
+				vecty.Class("mdc-text-field"),
+				vecty.Attribute("role", "combobox"),
+				vecty.Attribute("tabindex", 0),
+				vecty.Attribute("aria-haspopup", "listbox"),
+				vecty.Attribute("aria-expanded", c.open),
+				vecty.Attribute("aria-owns", id+"-listbox"),
+				vecty.Attribute("aria-labelledby", id+"-label"),
+				c.activeDescendant(id),
+				event.Click(c.onClick),
+			),
+			elem.Label(
+				vecty.Markup(
+					prop.ID(id+"-label"),
+					vecty.Class("mdc-text-field__label"),
+					vecty.MarkupIf(len(chips) > 1 || c.open,
+						vecty.Class("mdc-text-field__label--float-above")),
+				),
+				vecty.Text(c.Label),
+			),
+			elem.Div(chips...),
+			elem.Div(
+				vecty.Markup(
+					vecty.Class("mdc-line-ripple"),
+				),
+			),
+		),
+		&menu.M{
+			Root: vecty.Markup(applyer.CSSOnly()),
+			Open: c.open,
+			List: &ul.L{
+				Root: vecty.Markup(
+					prop.ID(id+"-listbox"),
+					vecty.Attribute("role", "listbox"),
+					vecty.Attribute("aria-multiselectable", "true"),
+					vecty.Attribute("aria-labelledby", id+"-label"),
+				),
+				Items: items,
+			},
+		},
+	)
+	return c.root
+}
+
+// search returns the list item holding the search field.
+func (c *S) search(id string) vecty.ComponentOrHTML {
+	return elem.ListItem(
+		vecty.Markup(
+			vecty.Attribute("role", "presentation"),
+		),
+		elem.Input(
+			vecty.Markup(
+				prop.ID(id+"-search"),
+				vecty.Class("mdc-text-field__input"),
+				prop.Type(prop.TypeSearch),
+				prop.Placeholder("Search"),
+				prop.Value(c.query),
+				vecty.Attribute("aria-label", "Search "+c.Label),
+				vecty.Attribute("aria-controls", id+"-listbox"),
+				c.activeDescendant(id),
+				event.Input(func(e *vecty.Event) {
+					c.query = e.Get("target").Get("value").String()
+					c.highlighted = -1
+					vecty.Rerender(c)
+				}),
+			),
+		),
+	)
+}
+
+// item returns the menu item of the option at index i in Options.
+func (c *S) item(id string, i int, checked bool) vecty.ComponentOrHTML {
+	o := c.Options[i]
+	return &ul.Item{
+		Root: vecty.Markup(
+			prop.ID(optionID(id, i)),
+			vecty.Attribute("role", "option"),
+			vecty.Attribute("aria-selected", checked),
+			vecty.MarkupIf(o.Disabled,
+				vecty.Attribute("aria-disabled", "true")),
+			// Keep the focus where it is when an option is clicked.
+			event.MouseDown(func(e *vecty.Event) {}).PreventDefault(),
+			event.Click(func(e *vecty.Event) {
+				c.toggle(o)
+			}),
+		),
+		Graphic: &checkbox.CB{
+			Root:       vecty.Markup(applyer.CSSOnly()),
+			Input:      vecty.Markup(vecty.Attribute("tabindex", -1)),
+			Checked:    checked,
+			Disabled:   o.Disabled,
+			Controlled: true,
+		},
+		Primary:   vecty.Text(o.Label),
+		Activated: i == c.highlighted,
+	}
+}
+
+// activeDescendant returns the markup pointing the element holding the focus
+// to the highlighted option.
+func (c *S) activeDescendant(id string) vecty.Applyer {
+	return vecty.MarkupIf(c.highlighted >= 0,
+		vecty.Attribute("aria-activedescendant", optionID(id, c.highlighted)),
+	)
+}
+
+// chip returns the chip of the selected option o.
+func (c *S) chip(o Option) vecty.ComponentOrHTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("mdc-chip"),
+		),
+		elem.Div(
+			vecty.Markup(
+				vecty.Class("mdc-chip__text"),
+			),
+			vecty.Text(o.Label),
+		),
+		vecty.If(!o.Disabled, elem.Italic(
+			vecty.Markup(
+				vecty.Class("material-icons"),
+				vecty.Class("mdc-chip__icon"),
+				vecty.Class("mdc-chip__icon--trailing"),
+				vecty.Attribute("role", "button"),
+				vecty.Attribute("tabindex", 0),
+				vecty.Attribute("aria-label", "Remove "+o.Label),
+				// Removing a chip does not open or close the menu.
+				event.Click(func(e *vecty.Event) {
+					c.toggle(o)
+				}).StopPropagation(),
+				event.KeyDown(func(e *vecty.Event) {
+					switch e.Get("key").String() {
+					case "Enter", " ", "Backspace", "Delete":
+						e.Call("preventDefault")
+						e.Call("stopPropagation")
+						c.toggle(o)
+					}
+				}),
+			),
+			vecty.Text("cancel"),
+		)),
+	)
+}
+
+func (c *S) onClick(e *vecty.Event) {
+	c.open = !c.open
+	vecty.Rerender(c)
+}
+
+func (c *S) onKeyDown(e *vecty.Event) {
+	// Keys typed in the search field are left to it, except for those moving
+	// through and checking the options.
+	inSearch := e.Get("target").Get("tagName").String() == "INPUT"
+	switch key := e.Get("key").String(); key {
+	case "ArrowDown", "ArrowUp":
+		e.Call("preventDefault")
+		if !c.open {
+			c.open = true
+			break
+		}
+		c.move(key == "ArrowDown")
+	case "Enter", " ":
+		if key == " " && inSearch {
+			return
+		}
+		e.Call("preventDefault")
+		if !c.open {
+			c.open = true
+			break
+		}
+		if c.highlighted >= 0 {
+			c.toggle(c.Options[c.highlighted])
+		}
+		return
+	case "Escape":
+		c.open = false
+	default:
+		return
+	}
+	vecty.Rerender(c)
+}
+
+// move highlights the next shown option, or the previous one if down is
+// false, wrapping around at either end.
+func (c *S) move(down bool) {
+	shown := c.shown()
+	if len(shown) == 0 {
+		c.highlighted = -1
+		return
+	}
+	pos := -1
+	for p, i := range shown {
+		if i == c.highlighted {
+			pos = p
+		}
+	}
+	switch {
+	case pos < 0 && down:
+		pos = 0
+	case pos < 0:
+		pos = len(shown) - 1
+	case down:
+		pos = (pos + 1) % len(shown)
+	default:
+		pos = (pos - 1 + len(shown)) % len(shown)
+	}
+	c.highlighted = shown[pos]
+}
+
+// onFocusOut closes the menu when focus moves out of the component.
+func (c *S) onFocusOut(e *vecty.Event) {
+	to := e.Get("relatedTarget")
+	if to.Truthy() && c.root.Node().Call("contains", to).Bool() {
+		return
+	}
+	c.open = false
+	vecty.Rerender(c)
+}
+
+// toggle selects the option o if it is not selected, and removes it from the
+// selection otherwise.
+func (c *S) toggle(o Option) {
+	if o.Disabled {
+		return
+	}
+	selected := c.selected()
+	selected[o.Value] = !selected[o.Value]
+	values := []string{}
+	for _, o := range c.Options {
+		if selected[o.Value] {
+			values = append(values, o.Value)
+		}
+	}
+	if !c.Controlled {
+		c.Selected = values
+		vecty.Rerender(c)
+	}
+	if c.OnChange != nil {
+		c.OnChange(c, values)
+	}
+}
+
+// shown returns the indices in Options of the options whose label contains the
+// search text, ignoring case.
+func (c *S) shown() []int {
+	query := strings.ToLower(c.query)
+	var shown []int
+	for i, o := range c.Options {
+		if strings.Contains(strings.ToLower(o.Label), query) {
+			shown = append(shown, i)
+		}
+	}
+	return shown
+}
+
+func (c *S) selected() map[string]bool {
+	selected := make(map[string]bool, len(c.Selected))
+	for _, v := range c.Selected {
+		selected[v] = true
+	}
+	return selected
+}
+
+func optionID(id string, i int) string {
+	return id + "-option-" + strconv.Itoa(i)
+}
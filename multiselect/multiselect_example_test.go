@@ -0,0 +1,59 @@
+package multiselect_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/multiselect"
+)
+
+func Example() {
+	s := &multiselect.S{
+		Label:      "Toppings",
+		Searchable: true,
+		Options: []multiselect.Option{
+			{Value: "cheese", Label: "Cheese"},
+			{Value: "ham", Label: "Ham"},
+			{Value: "mushrooms", Label: "Mushrooms"},
+		},
+		OnChange: func(this *multiselect.S, selected []string) {
+			fmt.Printf("Selected: %v\n", selected)
+		},
+	}
+	scr, err := materialtest.Mount(s)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer scr.Unmount()
+
+	materialtest.Click(scr.ByRole("combobox")[0])
+	materialtest.Input(scr.Query(`input[type="search"]`), "m")
+	for _, o := range scr.ByRole("option") {
+		fmt.Println(o.Get("textContent"))
+	}
+	materialtest.Click(scr.ByRole("option")[1])
+	materialtest.Input(scr.Query(`input[type="search"]`), "")
+	materialtest.Click(scr.ByRole("option")[0])
+
+	// Chips remove their option from the selection.
+	materialtest.Click(scr.ByLabelText("Remove Mushrooms"))
+	fmt.Printf("Chips: %d\n", len(scr.ByClass("mdc-chip")))
+
+	// Output:
+	// Ham
+	// Mushrooms
+	// Selected: [mushrooms]
+	// Selected: [cheese mushrooms]
+	// Selected: [cheese]
+	// Chips: 1
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
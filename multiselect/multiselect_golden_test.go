@@ -0,0 +1,21 @@
+package multiselect_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/multiselect"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"selected", &multiselect.S{Label: "Toppings", Selected: []string{"cheese"}, Options: []multiselect.Option{{Value: "cheese", Label: "Cheese"}, {Value: "olives", Label: "Olives", Disabled: true}}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
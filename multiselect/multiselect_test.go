@@ -0,0 +1,125 @@
+package multiselect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/multiselect"
+)
+
+func TestMenu(t *testing.T) {
+	s := &multiselect.S{
+		Label:      "Toppings",
+		Searchable: true,
+		Options: []multiselect.Option{
+			{Value: "cheese", Label: "Cheese"},
+			{Value: "ham", Label: "Ham"},
+			{Value: "mushrooms", Label: "Mushrooms"},
+		},
+		Selected: []string{"ham"},
+	}
+	scr, err := materialtest.Mount(s)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer scr.Unmount()
+	combobox := scr.ByRole("combobox")[0]
+	menu := scr.ByClass("mdc-menu")[0]
+	// checked returns the checked state of the checkboxes of the shown
+	// options.
+	checked := func() string {
+		var states []bool
+		for _, o := range scr.ByRole("option") {
+			states = append(states,
+				o.Call("querySelector", "input").Get("checked").Bool())
+		}
+		return fmt.Sprint(states)
+	}
+
+	materialtest.AssertNoClass(t, menu, "mdc-menu--open")
+	materialtest.Click(combobox)
+	materialtest.AssertClass(t, menu, "mdc-menu--open")
+	materialtest.AssertAria(t, combobox, "expanded", "true")
+	if got, want := checked(), "[false true false]"; got != want {
+		t.Errorf("checked = %s, want %s", got, want)
+	}
+
+	// The search field hides the options not matching it.
+	materialtest.Input(scr.Query(`input[type="search"]`), "m")
+	if got, want := checked(), "[true false]"; got != want {
+		t.Errorf("checked = %s, want %s", got, want)
+	}
+	materialtest.Click(scr.ByRole("option")[1])
+	if got, want := fmt.Sprint(s.Selected), "[ham mushrooms]"; got != want {
+		t.Errorf("Selected = %s, want %s", got, want)
+	}
+	if got, want := checked(), "[true true]"; got != want {
+		t.Errorf("checked = %s, want %s", got, want)
+	}
+
+	materialtest.Input(scr.Query(`input[type="search"]`), "")
+	materialtest.Click(scr.ByRole("option")[1])
+	if got, want := checked(), "[false false true]"; got != want {
+		t.Errorf("checked = %s, want %s", got, want)
+	}
+
+	materialtest.PressKey(combobox, "Escape")
+	materialtest.AssertNoClass(t, menu, "mdc-menu--open")
+	materialtest.AssertAria(t, combobox, "expanded", "false")
+}
+
+func TestKeyboard(t *testing.T) {
+	var changes []string
+	s := &multiselect.S{
+		Label: "Toppings",
+		Options: []multiselect.Option{
+			{Value: "cheese", Label: "Cheese"},
+			{Value: "ham", Label: "Ham"},
+		},
+		OnChange: func(this *multiselect.S, selected []string) {
+			changes = append(changes, fmt.Sprint(selected))
+		},
+	}
+	scr, err := materialtest.Mount(s)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer scr.Unmount()
+	combobox := scr.ByRole("combobox")[0]
+	menu := scr.ByClass("mdc-menu")[0]
+	options := scr.ByRole("option")
+	assertHighlighted := func(i int) {
+		t.Helper()
+		materialtest.AssertAttr(t, combobox, "aria-activedescendant",
+			options[i].Get("id").String())
+		materialtest.AssertClass(t, options[i], "mdc-list-item--activated")
+		materialtest.AssertNoClass(t, options[1-i],
+			"mdc-list-item--activated")
+	}
+
+	materialtest.PressKey(combobox, "ArrowDown")
+	materialtest.AssertClass(t, menu, "mdc-menu--open")
+	materialtest.AssertNoAttr(t, combobox, "aria-activedescendant")
+
+	// The arrow keys wrap around.
+	for _, i := range []int{0, 1, 0} {
+		materialtest.PressKey(combobox, "ArrowDown")
+		assertHighlighted(i)
+	}
+	materialtest.PressKey(combobox, "ArrowUp")
+	assertHighlighted(1)
+
+	materialtest.PressKey(combobox, "Enter")
+	materialtest.PressKey(combobox, "ArrowUp")
+	materialtest.PressKey(combobox, " ")
+	materialtest.AssertAria(t, options[0], "selected", "true")
+	materialtest.AssertAria(t, options[1], "selected", "true")
+	if got, want := fmt.Sprint(changes), "[[ham] [cheese ham]]"; got != want {
+		t.Errorf("OnChange values = %s, want %s", got, want)
+	}
+
+	materialtest.PressKey(combobox, "Escape")
+	materialtest.AssertNoClass(t, menu, "mdc-menu--open")
+	materialtest.AssertNoAttr(t, combobox, "aria-activedescendant")
+}
@@ -0,0 +1,115 @@
+package radio
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/bind"
+	"github.com/vecty-material/material/formfield"
+)
+
+// Option is an option of a Group.
+type Option struct {
+	Value    string
+	Label    string
+	Disabled bool
+}
+
+// Group is a vecty-material radio group component. It renders a radio with a
+// label for each of its Options, all sharing Name, of which the one whose
+// value is Value is checked.
+type Group struct {
+	vecty.Core
+	Root    vecty.MarkupOrChild `vecty:"prop"`
+	Options []Option            `vecty:"prop"`
+
+	// Name is the name of the group's radios. If empty, a name is allocated
+	// with base.AutoID.
+	Name string `vecty:"prop"`
+
+	// Value is the value of the checked option, or empty if none is checked.
+	Value string `vecty:"prop"`
+
+	// Vertical stacks the options instead of laying them out in a row.
+	Vertical bool `vecty:"prop"`
+
+	// Disabled disables every option.
+	Disabled bool `vecty:"prop"`
+
+	// OnChange is called with the new Value when the user checks an option.
+	OnChange func(value string) `vecty:"prop"`
+
+	// Controlled leaves Value unchanged when the user checks an option, see
+	// R. OnChange then reports the value the user asked for.
+	Controlled bool `vecty:"prop"`
+
+	// Bind, if set, is read into Value on render, and the value the user
+	// asked for is written to it on change.
+	Bind  *bind.String `vecty:"prop"`
+	bound bind.Binding
+
+	autoName string
+}
+
+// Render implements the vecty.Component interface.
+func (c *Group) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Value)
+
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	name := c.Name
+	if name == "" {
+		name = base.AutoID(&c.autoName)
+	}
+
+	options := make(vecty.List, len(c.Options))
+	for i, o := range c.Options {
+		options[i] = &formfield.FF{
+			Root: vecty.Markup(
+				vecty.MarkupIf(c.Vertical, vecty.Style("display", "flex")),
+			),
+			Label: o.Label,
+			Input: &R{
+				Name:     name,
+				Value:    o.Value,
+				Checked:  o.Value == c.Value,
+				Disabled: c.Disabled || o.Disabled,
+				// The group, not the radio, holds the state.
+				Controlled: true,
+				OnChange:   c.onChange,
+			},
+		}
+	}
+
+	return elem.Div(
+		vecty.Markup(
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			vecty.Attribute("role", "radiogroup"),
+		),
+		options,
+	)
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (c *Group) Unmount() {
+	c.bound.Release()
+}
+
+func (c *Group) onChange(r *R, e *vecty.Event) {
+	if r.Checked {
+		// Already checked.
+		return
+	}
+	if !c.Controlled {
+		c.Value = r.Value
+		vecty.Rerender(c)
+	}
+	c.Bind.Set(r.Value)
+	if c.OnChange != nil {
+		c.OnChange(r.Value)
+	}
+}
@@ -1,6 +1,9 @@
 package radio
 
 import (
+	"strconv"
+	"syscall/js"
+
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/gopherjs/vecty/event"
@@ -11,18 +14,30 @@ import (
 )
 
 // R is a vecty-material radio component.
+//
+// By default the user checking the radio sets Checked before OnChange is
+// called. If Controlled is set, Checked is left unchanged and the radios of
+// the group keep showing their Checked fields: OnChange reports that the user
+// asked for the radio to be checked, and the change only happens when the
+// radios are rendered with new values.
 type R struct {
 	*base.MDC
 	vecty.Core
-	Root     vecty.MarkupOrChild
-	Input    vecty.MarkupOrChild
-	OnChange func(this *R, e *vecty.Event)
-	Name     string
-	Checked  bool
-	Disabled bool
-	Value    string
+	Root       vecty.MarkupOrChild           `vecty:"prop"`
+	Input      vecty.MarkupOrChild           `vecty:"prop"`
+	OnChange   func(this *R, e *vecty.Event) `vecty:"prop"`
+	Name       string                        `vecty:"prop"`
+	Checked    bool                          `vecty:"prop"`
+	Disabled   bool                          `vecty:"prop"`
+	Value      string                        `vecty:"prop"`
+	Controlled bool                          `vecty:"prop"`
 }
 
+// checkedData is the dataset key holding the Checked field of a controlled
+// radio on its native input, so the checked state of a whole group can be
+// restored from the DOM after the user changed it.
+const checkedData = "vectyMaterialChecked"
+
 // Render implements the vecty.Component interface.
 func (c *R) Render() vecty.ComponentOrHTML {
 	rootMarkup := base.MarkupOnly(c.Root)
@@ -37,7 +52,7 @@ func (c *R) Render() vecty.ComponentOrHTML {
 	return elem.Div(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		input,
 		elem.Div(
@@ -72,14 +87,48 @@ func (c *R) Apply(h *vecty.HTML) {
 }
 
 func (c *R) onChange(e *vecty.Event) {
-	if r, ok := c.MDC.Component.(*radio.R); ok {
-		c.Checked = r.Checked
-		c.Disabled = r.Disabled
-		c.Value = r.Value
+	input := e.Get("target")
+	if !c.Controlled {
+		c.Checked = input.Get("checked").Bool()
 	}
 	if c.OnChange != nil {
 		c.OnChange(c, e)
 	}
+	if !c.Controlled {
+		return
+	}
+	for _, r := range group(input) {
+		checked := r.Get("dataset").Get(checkedData)
+		if !checked.IsUndefined() {
+			r.Set("checked", checked.String() == "true")
+		}
+	}
+}
+
+// group returns the radio inputs in the same group as the radio input e: those
+// with the same name that belong to the same form, or are outside of a form in
+// the same document. The result includes e.
+func group(e js.Value) []js.Value {
+	name := e.Get("name").String()
+	if name == "" {
+		return []js.Value{e}
+	}
+	form := e.Get("form")
+	var inputs js.Value
+	if form.Truthy() {
+		inputs = form.Get("elements")
+	} else {
+		inputs = e.Get("ownerDocument").Call("getElementsByName", name)
+	}
+	var rs []js.Value
+	for i := 0; i < inputs.Get("length").Int(); i++ {
+		r := inputs.Call("item", i)
+		if r.Get("type").String() == "radio" &&
+			r.Get("name").String() == name && r.Get("form").Equal(form) {
+			rs = append(rs, r)
+		}
+	}
+	return rs
 }
 
 func (c *R) NativeInput() (element *vecty.HTML, id string) {
@@ -102,8 +151,24 @@ func (c *R) NativeInput() (element *vecty.HTML, id string) {
 			vecty.MarkupIf(c.Value != "", prop.Value(c.Value)),
 			vecty.MarkupIf(c.Name != "", vecty.Property("name", c.Name)),
 			vecty.Property("disabled", c.Disabled),
+			vecty.MarkupIf(c.Controlled,
+				vecty.Data(checkedData, strconv.FormatBool(c.Checked)),
+			),
 		),
 	)
 	id = applyer.FindID(element)
 	return
 }
+
+// SetInputID sets the ID of c's built-in native input element. It does nothing
+// if c has a user supplied input element.
+func (c *R) SetInputID(id string) {
+	niMarkup := base.MarkupOnly(c.Input)
+	if c.Input != nil && niMarkup == nil {
+		return
+	}
+	c.Input = vecty.Markup(
+		prop.ID(id),
+		vecty.MarkupIf(niMarkup != nil, niMarkup),
+	)
+}
@@ -0,0 +1,25 @@
+package radio_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/radio"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &radio.R{}},
+		{"group", &radio.Group{Name: "plan", Value: "b", Options: []radio.Option{{Value: "a", Label: "A"}, {Value: "b", Label: "B", Disabled: true}}}},
+		{"group-vertical", &radio.Group{Vertical: true, Disabled: true, Options: []radio.Option{{Value: "a", Label: "A"}, {Value: "b", Label: "B"}}}},
+		{"checked-disabled", &radio.R{Input: vecty.Markup(prop.ID("r")), Name: "group", Value: "a", Checked: true, Disabled: true}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
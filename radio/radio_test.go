@@ -0,0 +1,161 @@
+package radio_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/radio"
+)
+
+// app renders the component returned by render, which builds it from the
+// app's state like an application would.
+type app struct {
+	vecty.Core
+	render func() vecty.ComponentOrHTML
+}
+
+func (c *app) Render() vecty.ComponentOrHTML {
+	return elem.Div(c.render())
+}
+
+func TestControlled(t *testing.T) {
+	value, accept := "a", false
+	a := &app{}
+	r := func(form, v string) *radio.R {
+		return &radio.R{
+			Input:      vecty.Markup(prop.ID(form + v)),
+			Name:       "group",
+			Value:      v,
+			Checked:    v == value,
+			Controlled: true,
+			OnChange: func(this *radio.R, e *vecty.Event) {
+				if accept {
+					value = this.Value
+					vecty.Rerender(a)
+				}
+			},
+		}
+	}
+	a.render = func() vecty.ComponentOrHTML {
+		return vecty.List{
+			elem.Form(r("x", "a"), r("x", "b")),
+			// A group of the same name in another form.
+			elem.Form(
+				&radio.R{
+					Input:   vecty.Markup(prop.ID("ya")),
+					Name:    "group",
+					Value:   "a",
+					Checked: true,
+				},
+				&radio.R{
+					Input: vecty.Markup(prop.ID("yb")),
+					Name:  "group",
+					Value: "b",
+				},
+			),
+		}
+	}
+	s, err := materialtest.Mount(a)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	assert := func(checked ...bool) {
+		t.Helper()
+		for i, id := range []string{"xa", "xb", "ya", "yb"} {
+			materialtest.AssertProp(t, s.ByID(id), "checked", checked[i])
+		}
+	}
+
+	// A rejected change is undone, without touching the other form.
+	materialtest.Click(s.ByID("yb"))
+	assert(true, false, false, true)
+	materialtest.Click(s.ByID("xb"))
+	assert(true, false, false, true)
+
+	accept = true
+	materialtest.Click(s.ByID("xb"))
+	assert(false, true, false, true)
+	if value != "b" {
+		t.Errorf("value = %q, want %q", value, "b")
+	}
+
+	// Rendering the radios with new values changes them, and later rejected
+	// changes are undone to those values.
+	value = "a"
+	vecty.Rerender(a)
+	assert(true, false, false, true)
+	accept = false
+	materialtest.Click(s.ByID("xb"))
+	assert(true, false, false, true)
+}
+
+func TestGroup(t *testing.T) {
+	var changes []string
+	g := &radio.Group{
+		Options: []radio.Option{
+			{Value: "a", Label: "A"},
+			{Value: "b", Label: "B"},
+			{Value: "c", Label: "C"},
+		},
+		Value: "a",
+		OnChange: func(value string) {
+			changes = append(changes, value)
+		},
+	}
+	s, err := materialtest.Mount(g)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	assert := func(checked ...bool) {
+		t.Helper()
+		for i, label := range []string{"A", "B", "C"} {
+			materialtest.AssertProp(t, s.ByLabelText(label), "checked",
+				checked[i])
+		}
+	}
+
+	materialtest.Click(s.ByLabelText("B"))
+	if g.Value != "b" {
+		t.Errorf("Value = %q, want %q", g.Value, "b")
+	}
+	assert(false, true, false)
+
+	materialtest.Click(s.ByLabelText("C"))
+	if g.Value != "c" {
+		t.Errorf("Value = %q, want %q", g.Value, "c")
+	}
+	assert(false, false, true)
+
+	// Clicking the checked option is not a change.
+	materialtest.Click(s.ByLabelText("C"))
+	if got, want := fmt.Sprint(changes), "[b c]"; got != want {
+		t.Errorf("OnChange values = %s, want %s", got, want)
+	}
+
+	// A controlled group only changes when rendered with a new Value.
+	g.Controlled = true
+	materialtest.Click(s.ByLabelText("A"))
+	if g.Value != "c" {
+		t.Errorf("Value = %q, want %q", g.Value, "c")
+	}
+	assert(false, false, true)
+	g.Value = "a"
+	vecty.Rerender(g)
+	assert(true, false, false)
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
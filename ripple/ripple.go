@@ -2,6 +2,7 @@ package ripple
 
 import (
 	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/base/applyer"
 	"github.com/vecty-material/material/material/ripple"
 )
 
@@ -15,12 +16,7 @@ type R struct {
 func (c *R) Apply(h *vecty.HTML) {
 	c.R = ripple.New()
 	c.Root = h
-	// start := func() error {
-	// 	return c.R.Start(c.Root.Node())
-	// }
-	// vecty.Property("vecty-material-ripple", js.InternalObject(start)).Apply(h)
-	// vecty.Property("vecty-material-ripple", &start).Apply(h)
-	vecty.Property("vecty-material-ripple", c).Apply(h)
+	applyer.SetRipple(h, c)
 }
 
 func (c *R) Start() error {
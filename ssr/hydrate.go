@@ -0,0 +1,89 @@
+package ssr
+
+import (
+	"fmt"
+	"strconv"
+	"syscall/js"
+
+	"github.com/vecty-material/material/base"
+	mbase "github.com/vecty-material/material/material/base"
+
+	// Register the material components with material/base so that they can
+	// be created from the type name in the markup.
+	_ "github.com/vecty-material/material/material/checkbox"
+	_ "github.com/vecty-material/material/material/dialog"
+	_ "github.com/vecty-material/material/material/formfield"
+	_ "github.com/vecty-material/material/material/icontoggle"
+	_ "github.com/vecty-material/material/material/linearprogress"
+	_ "github.com/vecty-material/material/material/menu"
+	_ "github.com/vecty-material/material/material/persistentdrawer"
+	_ "github.com/vecty-material/material/material/radio"
+	_ "github.com/vecty-material/material/material/ripple"
+	_ "github.com/vecty-material/material/material/selection"
+	_ "github.com/vecty-material/material/material/slider"
+	_ "github.com/vecty-material/material/material/snackbar"
+	_ "github.com/vecty-material/material/material/temporarydrawer"
+	_ "github.com/vecty-material/material/material/textfield"
+	_ "github.com/vecty-material/material/material/toolbar"
+)
+
+// Components holds the components started by Hydrate, keyed by the value of
+// their root element's base.PrerenderIDAttr attribute. Components started for
+// markup that was not prerendered are keyed by their root element's id, or by
+// "auto-" followed by their position in document order if it has none.
+type Components map[string]mbase.ComponentStartStopper
+
+// Hydrate starts a material component for every element inside root that was
+// marked by RenderToString, using the AutoInit func of material/base. If a
+// component fails to start, the components started so far are stopped and the
+// error is returned.
+func Hydrate(root js.Value) (Components, error) {
+	restoreIndeterminate(root)
+
+	r, err := mbase.AutoInit(root)
+	if err != nil {
+		return nil, fmt.Errorf("ssr: %v", err)
+	}
+	cs := Components{}
+	for i, c := range r.Components() {
+		e := c.Component().MDCState.RootElement
+		id := e.Call("getAttribute", base.PrerenderIDAttr)
+		switch {
+		case !id.IsNull():
+			cs[id.String()] = c
+		case e.Get("id").String() != "":
+			cs[e.Get("id").String()] = c
+		default:
+			cs["auto-"+strconv.Itoa(i)] = c
+		}
+	}
+	return cs, nil
+}
+
+// Stop stops every component in cs, so that Hydrate can start them again. It
+// returns the first error encountered.
+func (cs Components) Stop() error {
+	var first error
+	for id, c := range cs {
+		e := c.Component().MDCState.RootElement
+		if !e.IsUndefined() && !e.IsNull() {
+			e.Call("removeAttribute", mbase.AutoInitStateAttr)
+		}
+		if err := c.Stop(); err != nil && first == nil {
+			first = err
+		}
+		delete(cs, id)
+	}
+	return first
+}
+
+// restoreIndeterminate sets the indeterminate property, which has no HTML
+// attribute, on the inputs reflectProperties marked, and removes the marker.
+func restoreIndeterminate(root js.Value) {
+	inputs := root.Call("querySelectorAll", "[data-indeterminate]")
+	for i := 0; i < inputs.Get("length").Int(); i++ {
+		e := inputs.Call("item", i)
+		e.Set("indeterminate", true)
+		e.Call("removeAttribute", "data-indeterminate")
+	}
+}
@@ -0,0 +1,119 @@
+// ssr renders vecty-material components to static HTML, and starts the
+// material components of that HTML once the GopherJS bundle is loaded.
+//
+// Rendering happens in an emulated DOM, so RenderToString runs anywhere the
+// GopherJS output runs under Node with the jsdom module available, for example
+// on a server or as a build step. The markup produced is the same markup vecty
+// produces in a browser, with every material component's root element marked
+// with base.PrerenderTypeAttr and base.PrerenderIDAttr. In the browser, call
+// Hydrate to start those components without waiting for vecty to render.
+package ssr // import "github.com/vecty-material/material/ssr"
+
+import (
+	"sync"
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/gojs/jsdom"
+)
+
+// body wraps the component being rendered so it can be passed to
+// vecty.RenderBody.
+type body struct {
+	vecty.Core
+	child vecty.ComponentOrHTML
+}
+
+// Render implements the vecty.Component interface.
+func (c *body) Render() vecty.ComponentOrHTML {
+	return elem.Body(c.child)
+}
+
+// rendering serializes calls to RenderToString, as prerender mode and the
+// globals useDOM replaces are shared by the whole process.
+var rendering sync.Mutex
+
+// RenderToString renders c into an emulated DOM and returns the resulting
+// markup. MDC components are not started, see base.BeginPrerender.
+//
+// RenderToString is safe to call from concurrent goroutines, such as the
+// handlers of an HTTP server, but renders one component tree at a time.
+func RenderToString(c vecty.ComponentOrHTML) (html string, err error) {
+	rendering.Lock()
+	defer rendering.Unlock()
+
+	dom, err := jsdom.New(`<html><body></body></html>`, &jsdom.M{})
+	if err != nil {
+		return "", err
+	}
+	restore := useDOM(dom)
+	defer restore()
+
+	defer gojs.CatchException(&err)
+	base.BeginPrerender()
+	defer base.EndPrerender()
+	vecty.RenderBody(&body{child: c})
+
+	b := dom.Document().Get("body")
+	reflectProperties(b)
+	return b.Get("innerHTML").String(), err
+}
+
+// useDOM points the globals vecty and MDC rely on at dom, and returns a
+// function that restores their previous values.
+func useDOM(dom jsdom.JSDOM) (restore func()) {
+	g := js.Global()
+	w := dom.Window()
+	globals := map[string]js.Value{
+		"window":                w,
+		"document":              w.Get("document"),
+		"HTMLElement":           w.Get("HTMLElement"),
+		"requestAnimationFrame": w.Get("requestAnimationFrame"),
+		"cancelAnimationFrame":  w.Get("cancelAnimationFrame"),
+		"getComputedStyle":      w.Get("getComputedStyle"),
+	}
+	prev := make(map[string]js.Value, len(globals))
+	for k, v := range globals {
+		prev[k] = g.Get(k)
+		g.Set(k, v)
+	}
+	return func() {
+		for k, v := range prev {
+			g.Set(k, v)
+		}
+	}
+}
+
+// reflectProperties copies the form control properties vecty sets, which are
+// not serialized by innerHTML, onto their matching attributes.
+func reflectProperties(root js.Value) {
+	inputs := root.Call("querySelectorAll", "input, select, textarea")
+	for i := 0; i < inputs.Get("length").Int(); i++ {
+		e := inputs.Call("item", i)
+		props := []string{"disabled"}
+		isInput := e.Get("tagName").String() == "INPUT"
+		if isInput {
+			// Only inputs have the checked and indeterminate properties.
+			props = append(props, "checked")
+		}
+		for _, p := range props {
+			if e.Get(p).Bool() {
+				e.Call("setAttribute", p, "")
+			} else {
+				e.Call("removeAttribute", p)
+			}
+		}
+		if !isInput {
+			continue
+		}
+		if e.Get("indeterminate").Bool() {
+			e.Call("setAttribute", "data-indeterminate", "true")
+		}
+		if v := e.Get("value").String(); v != "" {
+			e.Call("setAttribute", "value", v)
+		}
+	}
+}
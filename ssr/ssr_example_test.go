@@ -0,0 +1,72 @@
+package ssr_test
+
+import (
+	"fmt"
+	"log"
+	"syscall/js"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/gojs"
+	"github.com/vecty-material/material/gojs/jsdom"
+	"github.com/vecty-material/material/ssr"
+)
+
+func Example() {
+	// On the server, or as a build step.
+	html, err := ssr.RenderToString(&checkbox.CB{
+		Input:   vecty.Markup(prop.ID("subscribe")),
+		Checked: true,
+	})
+	if err != nil {
+		log.Fatalf("Unable to render component: %v\n", err)
+	}
+
+	// In the browser, once the page holding html is loaded.
+	body := js.Global().Get("document").Get("body")
+	body.Set("innerHTML", html)
+	cs, err := ssr.Hydrate(body)
+	if err != nil {
+		log.Fatalf("Unable to hydrate components: %v\n", err)
+	}
+	defer cs.Stop()
+	c := cs["vm-0"].Component()
+	fmt.Printf("%s started: %v\n", c.Type.MDCClassName, c.MDCState.Started)
+	fmt.Printf("Checked: %v\n", c.Get("checked"))
+	fmt.Printf("Input: %v\n", body.Call("querySelector", "#subscribe").
+		Get("checked"))
+
+	// Output:
+	// MDCCheckbox started: true
+	// Checked: true
+	// Input: true
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := emulateDOM()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
+
+func emulateDOM() (err error) {
+	defer gojs.CatchException(&err)
+	g := js.Global()
+	g.Set("mdc", g.Call("require",
+		"material-components-web/dist/material-components-web"))
+	dom, err := jsdom.New(`<html><body></body></html>`,
+		&jsdom.M{"pretendToBeVisual": true})
+	if err != nil {
+		return err
+	}
+	w := dom.Window()
+	for _, k := range []string{"document", "HTMLElement",
+		"requestAnimationFrame", "cancelAnimationFrame", "getComputedStyle"} {
+		g.Set(k, w.Get(k))
+	}
+	g.Set("window", w)
+	return err
+}
@@ -0,0 +1,116 @@
+package ssr_test
+
+import (
+	"strings"
+	"syscall/js"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/checkbox"
+	mbase "github.com/vecty-material/material/material/base"
+	"github.com/vecty-material/material/ssr"
+)
+
+func TestRenderToString(t *testing.T) {
+	html, err := ssr.RenderToString(&checkbox.CB{
+		Input:   vecty.Markup(prop.ID("subscribe")),
+		Checked: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		base.PrerenderTypeAttr + `="MDCCheckbox"`,
+		base.PrerenderIDAttr + `="vm-0"`,
+		`id="subscribe"`,
+		"checked",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("RenderToString() does not contain %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestRenderToStringDeterministic(t *testing.T) {
+	render := func() string {
+		html, err := ssr.RenderToString(vecty.List{
+			&checkbox.CB{Input: vecty.Markup(prop.ID("a"))},
+			&checkbox.CB{Input: vecty.Markup(prop.ID("b"))},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return html
+	}
+	first, second := render(), render()
+	if first != second {
+		t.Errorf("renders differ:\n%s\n%s", first, second)
+	}
+	if want := base.PrerenderIDAttr + `="vm-1"`; !strings.Contains(first, want) {
+		t.Errorf("RenderToString() does not contain %q:\n%s", want, first)
+	}
+}
+
+func TestRenderToStringFormControls(t *testing.T) {
+	html, err := ssr.RenderToString(elem.Form(
+		elem.Select(
+			vecty.Markup(prop.Disabled(true)),
+			elem.Option(vecty.Text("A")),
+		),
+		elem.TextArea(vecty.Markup(prop.Disabled(true))),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(html, "disabled"); n != 2 {
+		t.Errorf("RenderToString() has %d disabled attributes, want 2:\n%s",
+			n, html)
+	}
+}
+
+func TestHydrateIndeterminate(t *testing.T) {
+	html, err := ssr.RenderToString(&checkbox.CB{Indeterminate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := js.Global().Get("document").Call("createElement", "div")
+	root.Set("innerHTML", html)
+	cs, err := ssr.Hydrate(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cs.Stop()
+	input := root.Call("querySelector", "input")
+	if !input.Get("indeterminate").Bool() {
+		t.Error("input is not indeterminate after Hydrate")
+	}
+	if input.Call("hasAttribute", "data-indeterminate").Bool() {
+		t.Error("input still has data-indeterminate after Hydrate")
+	}
+}
+
+// starter counts the calls made to its Start method.
+type starter struct {
+	mdc    mbase.Component
+	starts int
+}
+
+func (c *starter) Component() *mbase.Component   { return c.mdc.Component() }
+func (c *starter) Start(rootElem js.Value) error { c.starts++; return nil }
+func (c *starter) Stop() error                   { return nil }
+
+func TestRenderToStringSkipsStart(t *testing.T) {
+	c := &starter{}
+	_, err := ssr.RenderToString(&checkbox.CB{
+		MDC: &base.MDC{Component: c},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.starts != 0 {
+		t.Errorf("Start called %d times while prerendering", c.starts)
+	}
+}
@@ -0,0 +1,196 @@
+package textfield
+
+import (
+	"strings"
+	"syscall/js"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Formatter formats the value of a textfield as the user types. The raw value
+// is what the user entered without formatting, such as the digits of a phone
+// number, and the formatted value is what is shown in the field.
+//
+// For every text, Raw(Format(Raw(text))) must equal Raw(text), and Raw of a
+// prefix of text must be a prefix of Raw(text), so the caret can be kept after
+// the same raw character when the text is reformatted.
+type Formatter interface {
+	// Raw returns the raw value of text, dropping formatting and the
+	// characters that are not accepted.
+	Raw(text string) string
+	// Format returns the formatted value of the raw value raw.
+	Format(raw string) string
+}
+
+// Mask returns a Formatter filling the slots of pattern with the characters
+// the user types. In pattern, 9 is a slot for a digit, a for a letter and * for
+// a letter or digit; other characters are literals, which are inserted as the
+// user reaches them and are not part of the raw value.
+func Mask(pattern string) Formatter {
+	return mask([]rune(pattern))
+}
+
+// Phone returns a Formatter for US phone numbers, such as (555) 123-4567.
+func Phone() Formatter {
+	return Mask("(999) 999-9999")
+}
+
+// Date returns a Formatter for dates written MM/DD/YYYY.
+func Date() Formatter {
+	return Mask("99/99/9999")
+}
+
+// CreditCard returns a Formatter for card numbers of up to 19 digits, in groups
+// of four.
+func CreditCard() Formatter {
+	return Mask("9999 9999 9999 9999 999")
+}
+
+type mask []rune
+
+func (m mask) Raw(text string) string {
+	var raw []rune
+	i := 0
+	for _, r := range text {
+		for i < len(m) && !isSlot(m[i]) && m[i] != r {
+			i++
+		}
+		if i == len(m) {
+			break
+		}
+		if !isSlot(m[i]) {
+			// The literal itself.
+			i++
+			continue
+		}
+		if accepts(m[i], r) {
+			raw = append(raw, r)
+			i++
+		}
+	}
+	return string(raw)
+}
+
+func (m mask) Format(raw string) string {
+	var b strings.Builder
+	rs := []rune(raw)
+	for i := 0; i < len(m) && len(rs) > 0; {
+		switch {
+		case !isSlot(m[i]):
+			b.WriteRune(m[i])
+			i++
+		case accepts(m[i], rs[0]):
+			b.WriteRune(rs[0])
+			rs = rs[1:]
+			i++
+		default:
+			rs = rs[1:]
+		}
+	}
+	return b.String()
+}
+
+func isSlot(r rune) bool {
+	return r == '9' || r == 'a' || r == '*'
+}
+
+func accepts(slot, r rune) bool {
+	switch slot {
+	case '9':
+		return unicode.IsDigit(r)
+	case 'a':
+		return unicode.IsLetter(r)
+	}
+	return unicode.IsDigit(r) || unicode.IsLetter(r)
+}
+
+// Currency returns a Formatter for amounts of money with up to decimals digits
+// after the decimal point, shown with symbol as prefix and thousands grouped,
+// such as $1,234.50. The raw value is the number, such as 1234.50.
+func Currency(symbol string, decimals int) Formatter {
+	return currency{symbol: symbol, decimals: decimals}
+}
+
+type currency struct {
+	symbol   string
+	decimals int
+}
+
+func (c currency) Raw(text string) string {
+	var b strings.Builder
+	point, frac := false, 0
+	// The symbol may itself contain a point, as in Rs.
+	for _, r := range strings.TrimPrefix(text, c.symbol) {
+		switch {
+		case r >= '0' && r <= '9' && !point:
+			b.WriteRune(r)
+		case r >= '0' && r <= '9' && frac < c.decimals:
+			b.WriteRune(r)
+			frac++
+		case r == '.' && !point && c.decimals > 0:
+			b.WriteRune(r)
+			point = true
+		}
+	}
+	return b.String()
+}
+
+func (c currency) Format(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	whole, frac := raw, ""
+	if i := strings.IndexByte(raw, '.'); i >= 0 {
+		whole, frac = raw[:i], raw[i:]
+	}
+	var b strings.Builder
+	b.WriteString(c.symbol)
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteString(frac)
+	return b.String()
+}
+
+// Raw returns the raw value of c, which is Value without the formatting of
+// Formatter, or Value if c has no Formatter.
+func (c *TF) Raw() string {
+	if c.Formatter == nil {
+		return c.Value
+	}
+	return c.Formatter.Raw(c.Value)
+}
+
+// format reformats the value of the native input element input with
+// c.Formatter, keeping the caret after the same raw character, and returns
+// the new value.
+func (c *TF) format(input js.Value) string {
+	text := input.Get("value").String()
+	f := c.Formatter
+	formatted := f.Format(f.Raw(text))
+	if formatted == text {
+		return text
+	}
+	// selectionStart is null for input types without a caret.
+	start := input.Get("selectionStart")
+	input.Set("value", formatted)
+	if start.Type() != js.TypeNumber {
+		return formatted
+	}
+	rs := []rune(text)
+	caret := start.Int()
+	if caret > len(rs) {
+		caret = len(rs)
+	}
+	n := utf8.RuneCountInString(f.Raw(string(rs[:caret])))
+	out := []rune(formatted)
+	p := 0
+	for p < len(out) && utf8.RuneCountInString(f.Raw(string(out[:p]))) < n {
+		p++
+	}
+	input.Call("setSelectionRange", p, p)
+	return formatted
+}
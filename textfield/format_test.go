@@ -0,0 +1,52 @@
+package textfield_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/textfield"
+)
+
+func TestFormatCaret(t *testing.T) {
+	tf := &textfield.TF{
+		Input:     vecty.Markup(prop.ID("phone")),
+		Label:     "Phone",
+		Value:     "(555) 123-4567",
+		Formatter: textfield.Phone(),
+	}
+	s, err := materialtest.Mount(tf)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("phone")
+
+	tests := []struct {
+		text  string
+		caret int
+		want  string
+		after int
+	}{
+		// Typing in a group keeps the caret after the typed digit.
+		{"(555) 1293-4567", 9, "(555) 129-3456", 9},
+		// Typing before a literal moves the caret past it.
+		{"(555) 1298-3456", 10, "(555) 129-8345", 11},
+		// Deleting a digit keeps the caret where it was.
+		{"(555) 19-8345", 7, "(555) 198-345", 7},
+	}
+	for _, tt := range tests {
+		// Setting the value moves the caret to the end, so the edit is
+		// made before placing it and firing the input event.
+		input.Set("value", tt.text)
+		input.Call("setSelectionRange", tt.caret, tt.caret)
+		materialtest.Dispatch(input, "input", nil)
+		if tf.Value != tt.want {
+			t.Errorf("typing %q: Value = %q, want %q", tt.text, tf.Value,
+				tt.want)
+		}
+		materialtest.AssertProp(t, input, "value", tt.want)
+		materialtest.AssertProp(t, input, "selectionStart", tt.after)
+	}
+}
@@ -0,0 +1,169 @@
+package textfield
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+)
+
+// Strength is the estimated strength of a password.
+type Strength struct {
+	// Score is from 0 for the weakest passwords to 1 for the strongest.
+	Score float64
+	// Label describes the strength to the user, such as "Weak".
+	Label string
+}
+
+// Estimator estimates the strength of a password.
+type Estimator func(password string) Strength
+
+// Estimate is a simple Estimator, scoring the length of a password and the
+// classes of characters it uses: lower and upper case letters, digits and
+// others. Passwords of fewer than 6 characters score 0.
+func Estimate(password string) Strength {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	n := utf8.RuneCountInString(password)
+	classes := lower + upper + digit + other
+	points := 0
+	if n >= 6 {
+		for _, ok := range []bool{n >= 8, n >= 12, classes >= 3,
+			classes == 4 || n >= 16} {
+			if ok {
+				points++
+			}
+		}
+	}
+	labels := []string{"Very weak", "Weak", "Fair", "Good", "Strong"}
+	return Strength{Score: float64(points) / 4, Label: labels[points]}
+}
+
+// Password is a vecty-material textfield component for passwords. A toggle at
+// the end of the field shows or hides the password, and a warning replaces the
+// helper text while Caps Lock is on.
+//
+// If Estimator is set, the strength of the password is shown under the field
+// by a progress bar and, unless it is empty, by the helper text. Estimate can
+// be used, or one better suited to the application plugged in.
+//
+// The other fields are those of the TF it renders.
+type Password struct {
+	vecty.Core
+	Root       vecty.MarkupOrChild                  `vecty:"prop"`
+	Input      vecty.MarkupOrChild                  `vecty:"prop"`
+	Label      string                               `vecty:"prop"`
+	Value      string                               `vecty:"prop"`
+	Disabled   bool                                 `vecty:"prop"`
+	HelperText string                               `vecty:"prop"`
+	OnInput    func(this *Password, e *vecty.Event) `vecty:"prop"`
+	Validators []Validator                          `vecty:"prop"`
+	ValidateOn Trigger                              `vecty:"prop"`
+	Estimator  Estimator                            `vecty:"prop"`
+	visible    bool
+	capsLock   bool
+	tf         *TF
+	toggle     *icontoggle.IT
+}
+
+// Render implements the vecty.Component interface.
+func (c *Password) Render() vecty.ComponentOrHTML {
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	var strength Strength
+	helperText := c.HelperText
+	switch {
+	case c.capsLock:
+		helperText = "Caps Lock is on."
+	case c.Estimator != nil && c.Value != "":
+		strength = c.Estimator(c.Value)
+		helperText = "Strength: " + strength.Label
+	}
+	typ := prop.TypePassword
+	if c.visible {
+		typ = prop.TypeText
+	}
+	niMarkup := base.MarkupOnly(c.Input)
+
+	// The textfield and toggle are kept across renders and updated in place.
+	if c.tf == nil {
+		c.toggle = &icontoggle.IT{
+			Root:          vecty.Markup(vecty.Class("mdc-text-field__icon")),
+			OnIcon:        &icon.I{Name: "visibility_off"},
+			OffIcon:       &icon.I{Name: "visibility"},
+			OnLabel:       "Hide password",
+			OffLabel:      "Show password",
+			ChangeHandler: c.onToggle,
+		}
+		c.tf = &TF{OnInput: c.onInput, TrailingIcon: c.toggle}
+	}
+	c.toggle.On = c.visible
+	c.toggle.Disabled = c.Disabled
+	c.tf.Input = vecty.Markup(
+		vecty.MarkupIf(niMarkup != nil, niMarkup),
+		event.KeyDown(c.onKey),
+		event.KeyUp(c.onKey),
+	)
+	c.tf.Label = c.Label
+	c.tf.Type = typ
+	c.tf.Value = c.Value
+	c.tf.Disabled = c.Disabled
+	c.tf.HelperText = helperText
+	c.tf.Persistent = c.capsLock || c.Estimator != nil && c.Value != ""
+	c.tf.Validators = c.Validators
+	c.tf.ValidateOn = c.ValidateOn
+
+	return elem.Div(
+		vecty.Markup(
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+		),
+		c.tf,
+		vecty.If(c.Estimator != nil, progress(true, strength.Score)),
+	)
+}
+
+func (c *Password) onInput(tf *TF, e *vecty.Event) {
+	c.Value = tf.Value
+	if c.OnInput != nil {
+		c.OnInput(c, e)
+	}
+	vecty.Rerender(c)
+}
+
+func (c *Password) onToggle(it *icontoggle.IT, e *vecty.Event) {
+	c.visible = it.On
+	vecty.Rerender(c)
+}
+
+// onKey tracks the state of Caps Lock from the key events of the input.
+func (c *Password) onKey(e *vecty.Event) {
+	if e.Get("getModifierState").IsUndefined() {
+		return
+	}
+	capsLock := e.Call("getModifierState", "CapsLock").Bool()
+	if capsLock != c.capsLock {
+		c.capsLock = capsLock
+		vecty.Rerender(c)
+	}
+}
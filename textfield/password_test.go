@@ -0,0 +1,76 @@
+package textfield_test
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/textfield"
+)
+
+// setCapsLock fires a keyup event on e with Caps Lock in the state on.
+func setCapsLock(e js.Value, on bool) {
+	view := e.Get("ownerDocument").Get("defaultView")
+	ev := view.Get("KeyboardEvent").New("keyup", map[string]interface{}{
+		"key":     "CapsLock",
+		"bubbles": true,
+	})
+	f := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return on && args[0].String() == "CapsLock"
+	})
+	defer f.Release()
+	ev.Set("getModifierState", f)
+	e.Call("dispatchEvent", ev)
+}
+
+func TestPassword(t *testing.T) {
+	pw := &textfield.Password{
+		Input:      vecty.Markup(prop.ID("pw")),
+		Label:      "Password",
+		HelperText: "At least 8 characters.",
+		Estimator:  textfield.Estimate,
+	}
+	s, err := materialtest.Mount(pw)
+	if err != nil {
+		t.Fatalf("Unable to mount component: %v", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("pw")
+	helper := s.ByID("pw-helper-text")
+	bar := s.ByRole("progressbar")[0]
+	toggle := s.ByClass("mdc-icon-toggle")[0]
+
+	// The toggle shows and hides the password.
+	materialtest.AssertProp(t, input, "type", "password")
+	materialtest.AssertAria(t, toggle, "label", "Show password")
+	materialtest.Click(toggle)
+	materialtest.AssertProp(t, input, "type", "text")
+	materialtest.AssertAria(t, toggle, "label", "Hide password")
+	materialtest.Click(toggle)
+	materialtest.AssertProp(t, input, "type", "password")
+
+	// The strength replaces the helper text once a password is typed.
+	materialtest.AssertProp(t, helper, "textContent", "At least 8 characters.")
+	materialtest.Input(input, "gopher123")
+	materialtest.AssertProp(t, helper, "textContent", "Strength: Weak")
+	materialtest.AssertClass(t, helper,
+		"mdc-text-field-helper-text--persistent")
+	materialtest.AssertAria(t, bar, "valuenow", "0.25")
+	materialtest.Input(input, "Gopher123!")
+	materialtest.AssertProp(t, helper, "textContent", "Strength: Good")
+	materialtest.AssertAria(t, bar, "valuenow", "0.75")
+
+	// And a warning replaces both while Caps Lock is on.
+	setCapsLock(input, true)
+	materialtest.AssertProp(t, helper, "textContent", "Caps Lock is on.")
+	setCapsLock(input, false)
+	materialtest.AssertProp(t, helper, "textContent", "Strength: Good")
+
+	materialtest.Input(input, "")
+	materialtest.AssertProp(t, helper, "textContent", "At least 8 characters.")
+	materialtest.AssertNoClass(t, helper,
+		"mdc-text-field-helper-text--persistent")
+	materialtest.AssertAttr(t, helper, "aria-hidden", "true")
+}
@@ -0,0 +1,46 @@
+package textfield
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+)
+
+// progress returns an MDC linear progress bar, showing value from 0 to 1 if
+// determinate is set. It is drawn by CSS alone, so no MDC component is
+// started for it.
+func progress(determinate bool, value float64) *vecty.HTML {
+	return elem.Div(
+		vecty.Markup(
+			vecty.Class("mdc-linear-progress"),
+			vecty.MarkupIf(!determinate,
+				vecty.Class("mdc-linear-progress--indeterminate")),
+			vecty.Attribute("role", "progressbar"),
+			vecty.MarkupIf(determinate,
+				vecty.Attribute("aria-valuemin", "0"),
+				vecty.Attribute("aria-valuemax", "1"),
+				vecty.Attribute("aria-valuenow", num(value)),
+			),
+		),
+		elem.Div(vecty.Markup(
+			vecty.Class("mdc-linear-progress__buffering-dots"))),
+		elem.Div(vecty.Markup(vecty.Class("mdc-linear-progress__buffer"))),
+		elem.Div(
+			vecty.Markup(
+				vecty.Class("mdc-linear-progress__bar"),
+				vecty.Class("mdc-linear-progress__primary-bar"),
+				vecty.MarkupIf(determinate,
+					vecty.Style("transform", "scaleX("+num(value)+")")),
+			),
+			elem.Span(vecty.Markup(
+				vecty.Class("mdc-linear-progress__bar-inner"))),
+		),
+		elem.Div(
+			vecty.Markup(
+				vecty.Class("mdc-linear-progress__bar"),
+				vecty.Class("mdc-linear-progress__secondary-bar"),
+			),
+			elem.Span(vecty.Markup(
+				vecty.Class("mdc-linear-progress__bar-inner"))),
+		),
+	)
+}
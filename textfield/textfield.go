@@ -0,0 +1,227 @@
+// https://material.io/components/web/catalog/input-controls/text-field/
+package textfield // import "github.com/vecty-material/material/textfield"
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/base/applyer"
+	"github.com/vecty-material/material/bind"
+	"github.com/vecty-material/material/material/textfield"
+)
+
+// TF is a vecty-material textfield component. It renders the MDC textfield
+// followed by its helper text, which is associated with the input through an
+// ID derived from the ID set in Input, or from one allocated with base.AutoID
+// if Input sets none.
+//
+// The user typing updates Value before OnInput is called. If Bind is set,
+// Value is read from it on render, and written to it as the user types.
+//
+// TrailingIcon is shown at the end of the field, and is given the class
+// mdc-text-field__icon if it is an HTML element.
+//
+// If Formatter is set, Value is formatted with it as the user types, and Raw
+// returns the value without formatting.
+//
+// Validators check Raw when the user leaves the field, and then as the user
+// types, or from the first keystroke if ValidateOn is ValidateOnInput. The
+// message of the first error replaces HelperText and the field is marked
+// invalid. AsyncValidators run once every Validator passes, and while they are
+// pending an indeterminate progress bar is shown under the field.
+type TF struct {
+	*base.MDC
+	vecty.Core
+	Root            vecty.MarkupOrChild            `vecty:"prop"`
+	Input           vecty.MarkupOrChild            `vecty:"prop"`
+	Label           string                         `vecty:"prop"`
+	Type            prop.InputType                 `vecty:"prop"`
+	Value           string                         `vecty:"prop"`
+	Disabled        bool                           `vecty:"prop"`
+	HelperText      string                         `vecty:"prop"`
+	Persistent      bool                           `vecty:"prop"`
+	Formatter       Formatter                      `vecty:"prop"`
+	TrailingIcon    vecty.ComponentOrHTML          `vecty:"prop"`
+	OnInput         func(this *TF, e *vecty.Event) `vecty:"prop"`
+	Validators      []Validator                    `vecty:"prop"`
+	AsyncValidators []Validator                    `vecty:"prop"`
+	ValidateOn      Trigger                        `vecty:"prop"`
+	Bind            *bind.String                   `vecty:"prop"`
+	bound           bind.Binding
+	autoID          string
+	validating      bool
+	err             error
+	pending         bool
+	seq             int
+}
+
+// Render implements the vecty.Component interface.
+func (c *TF) Render() vecty.ComponentOrHTML {
+	c.Bind.Sync(c, &c.bound, &c.Value)
+
+	if c.Formatter != nil {
+		c.Value = c.Formatter.Format(c.Formatter.Raw(c.Value))
+	}
+
+	rootMarkup := base.MarkupOnly(c.Root)
+	if c.Root != nil && rootMarkup == nil {
+		// User supplied root element.
+		return elem.Div(c.Root)
+	}
+
+	input, id := c.NativeInput()
+	if h, ok := c.TrailingIcon.(*vecty.HTML); ok && h != nil {
+		vecty.Class("mdc-text-field__icon").Apply(h)
+	}
+	helperText := c.HelperText
+	if c.err != nil {
+		helperText = c.err.Error()
+	}
+
+	// Built-in root element.
+	return elem.Div(
+		elem.Div(
+			vecty.Markup(
+				c,
+				vecty.MarkupIf(rootMarkup != nil, rootMarkup),
+			),
+			input,
+			vecty.If(c.Label != "", elem.Label(
+				vecty.Markup(
+					vecty.Class("mdc-text-field__label"),
+					vecty.MarkupIf(c.Value != "",
+						vecty.Class("mdc-text-field__label--float-above")),
+					prop.For(id),
+				),
+				vecty.Text(c.Label),
+			)),
+			c.TrailingIcon,
+			elem.Div(
+				vecty.Markup(
+					vecty.Class("mdc-line-ripple"),
+				),
+			),
+		),
+		vecty.If(c.pending, progress(false, 0)),
+		elem.Paragraph(
+			vecty.Markup(
+				prop.ID(id+"-helper-text"),
+				vecty.Class("mdc-text-field-helper-text"),
+				vecty.MarkupIf(c.Persistent || c.err != nil,
+					vecty.Class("mdc-text-field-helper-text--persistent")),
+				vecty.MarkupIf(c.err != nil,
+					vecty.Class("mdc-text-field-helper-text--validation-msg")),
+				vecty.MarkupIf(!c.Persistent && c.err == nil,
+					vecty.Attribute("aria-hidden", "true")),
+			),
+			vecty.Text(helperText),
+		),
+	)
+}
+
+func (c *TF) Apply(h *vecty.HTML) {
+	switch {
+	case c.MDC == nil:
+		c.MDC = &base.MDC{}
+		fallthrough
+	case c.MDC.Component == nil:
+		c.MDC.Component = textfield.New()
+		if tf, ok := c.MDC.Component.(*textfield.TF); ok {
+			tf.Value = c.Value
+			tf.Disabled = c.Disabled
+			tf.Valid = c.err == nil
+			tf.HelperText = c.HelperText
+		}
+	}
+
+	vecty.Markup(
+		vecty.Class("mdc-text-field"),
+		vecty.MarkupIf(c.Disabled, vecty.Class("mdc-text-field--disabled")),
+		vecty.MarkupIf(c.err != nil, vecty.Class("mdc-text-field--invalid")),
+		vecty.MarkupIf(c.TrailingIcon != nil,
+			vecty.Class("mdc-text-field--with-trailing-icon")),
+	).Apply(h)
+	c.MDC.RootElement = h
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (c *TF) Unmount() {
+	c.MDC.Unmount()
+	c.bound.Release()
+	// Drop the results of pending async validators.
+	c.seq++
+}
+
+// NativeInput returns the native input element of c and its ID.
+func (c *TF) NativeInput() (element *vecty.HTML, id string) {
+	niMarkup := base.MarkupOnly(c.Input)
+	if c.Input != nil && niMarkup == nil {
+		// User supplied input element.
+		element = elem.Input(c.Input)
+		id = applyer.FindID(element)
+		return
+	}
+
+	id = applyer.FindID(niMarkup)
+	if id == "" {
+		id = base.AutoID(&c.autoID)
+	}
+	typ := c.Type
+	if typ == "" {
+		typ = prop.TypeText
+	}
+
+	// Built-in input element.
+	element = elem.Input(
+		vecty.Markup(
+			prop.ID(id),
+			vecty.MarkupIf(niMarkup != nil, niMarkup),
+			event.Input(c.onInput),
+			event.Blur(c.onBlur),
+			vecty.Class("mdc-text-field__input"),
+			prop.Type(typ),
+			prop.Value(c.Value),
+			vecty.Property("disabled", c.Disabled),
+			vecty.Attribute("aria-controls", id+"-helper-text"),
+			vecty.MarkupIf(c.err != nil,
+				vecty.Attribute("aria-invalid", "true")),
+			vecty.MarkupIf(c.pending, vecty.Attribute("aria-busy", "true")),
+		),
+	)
+	return
+}
+
+// SetInputID sets the ID of c's built-in native input element. It does nothing
+// if c has a user supplied input element.
+func (c *TF) SetInputID(id string) {
+	niMarkup := base.MarkupOnly(c.Input)
+	if c.Input != nil && niMarkup == nil {
+		return
+	}
+	c.Input = vecty.Markup(
+		prop.ID(id),
+		vecty.MarkupIf(niMarkup != nil, niMarkup),
+	)
+}
+
+func (c *TF) onInput(e *vecty.Event) {
+	input := e.Get("target")
+	c.Value = input.Get("value").String()
+	if c.Formatter != nil {
+		c.Value = c.format(input)
+	}
+	c.Bind.Set(c.Value)
+	if c.validating || c.ValidateOn == ValidateOnInput {
+		c.validate()
+	}
+	if c.OnInput != nil {
+		c.OnInput(c, e)
+	}
+	vecty.Rerender(c)
+}
+
+func (c *TF) onBlur(e *vecty.Event) {
+	c.Validate()
+}
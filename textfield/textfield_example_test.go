@@ -0,0 +1,92 @@
+package textfield_test
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/textfield"
+)
+
+func Example() {
+	tf := &textfield.TF{
+		Input:      vecty.Markup(prop.ID("username")),
+		Label:      "Username",
+		HelperText: "Letters and digits only.",
+		Validators: []textfield.Validator{
+			textfield.Required(),
+			textfield.MinLength(3),
+			textfield.Pattern(regexp.MustCompile(`[a-z0-9]+`),
+				"Letters and digits only."),
+		},
+	}
+	s, err := materialtest.Mount(tf)
+	if err != nil {
+		log.Fatalf("Unable to mount component: %v\n", err)
+	}
+	defer s.Unmount()
+	input := s.ByID("username")
+
+	// Nothing is validated until the user leaves the field.
+	materialtest.Focus(input)
+	materialtest.Input(input, "go")
+	fmt.Printf("Error: %v\n", tf.Err())
+
+	materialtest.Blur(input)
+	fmt.Printf("Error: %v, helper text: %v\n", tf.Err(),
+		s.ByID("username-helper-text").Get("textContent"))
+
+	// From then on the value is validated as the user types.
+	materialtest.Input(input, "gopher")
+	fmt.Printf("Error: %v, valid: %v\n", tf.Err(), tf.Validate())
+
+	// Output:
+	// Error: <nil>
+	// Error: Must be at least 3 characters., helper text: Must be at least 3 characters.
+	// Error: <nil>, valid: true
+}
+
+func ExampleFormatter() {
+	phone := textfield.Phone()
+	fmt.Println(phone.Format(phone.Raw("555.123.4567")))
+
+	price := textfield.Currency("$", 2)
+	fmt.Println(price.Format("1234567.5"), price.Raw("$1,234.567"))
+
+	rupees := textfield.Currency("Rs.", 2)
+	fmt.Println(rupees.Format(rupees.Raw("Rs.1,234.5")))
+
+	plate := textfield.Mask("aaa-9999")
+	fmt.Println(plate.Format(plate.Raw("abc12345")))
+
+	// Output:
+	// (555) 123-4567
+	// $1,234,567.5 1234.56
+	// Rs.1,234.5
+	// abc-1234
+}
+
+func ExampleEstimate() {
+	for _, pw := range []string{"gopher", "gopher123", "Gopher123!", "correct horse battery staple"} {
+		s := textfield.Estimate(pw)
+		fmt.Printf("%s: %v %s\n", pw, s.Score, s.Label)
+	}
+
+	// Output:
+	// gopher: 0 Very weak
+	// gopher123: 0.25 Weak
+	// Gopher123!: 0.75 Good
+	// correct horse battery staple: 0.75 Good
+}
+
+func init() {
+	// We emulate a DOM here since tests run in NodeJS.
+	// Not needed when running in a browser.
+	err := materialtest.Init()
+	if err != nil {
+		log.Fatalf("Unable to setup test environment: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package textfield_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/textfield"
+)
+
+func TestGolden(t *testing.T) {
+	validated := func(c *textfield.TF) *textfield.TF {
+		c.Validate()
+		return c
+	}
+	never := func(value string) error { select {} }
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"default", &textfield.TF{Label: "Name"}},
+		{"value-helper-disabled", &textfield.TF{Input: vecty.Markup(prop.ID("name")), Label: "Name", Value: "Gopher", HelperText: "Your display name.", Persistent: true, Disabled: true}},
+		{"invalid", validated(&textfield.TF{Label: "Name", HelperText: "Your display name.", Validators: []textfield.Validator{textfield.Required()}})},
+		{"formatted", &textfield.TF{Label: "Phone", Value: "5551234567", Formatter: textfield.Phone()}},
+		{"pending", validated(&textfield.TF{Label: "Username", Value: "gopher", AsyncValidators: []textfield.Validator{never}})},
+		{"password", &textfield.Password{Label: "Password", Value: "gopher123", Estimator: textfield.Estimate}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -0,0 +1,184 @@
+package textfield
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gopherjs/vecty"
+)
+
+// Trigger is when a textfield first runs its validators.
+type Trigger int
+
+const (
+	// ValidateOnBlur validates when the user leaves the field, and as the
+	// user types from then on.
+	ValidateOnBlur Trigger = iota
+	// ValidateOnInput validates as the user types.
+	ValidateOnInput
+)
+
+// Validator checks the raw value of a textfield, returning an error whose
+// message is shown to the user if it is invalid. Any function with this
+// signature is a validator; Func builds one from a predicate.
+//
+// Except for Required, the validators of this package accept an empty value,
+// so optional fields can be left empty.
+type Validator func(value string) error
+
+// Required returns a Validator rejecting an empty value.
+func Required() Validator {
+	return func(value string) error {
+		if value == "" {
+			return errors.New("Required.")
+		}
+		return nil
+	}
+}
+
+// MinLength returns a Validator rejecting values shorter than n characters.
+func MinLength(n int) Validator {
+	return func(value string) error {
+		if value != "" && utf8.RuneCountInString(value) < n {
+			return fmt.Errorf("Must be at least %d characters.", n)
+		}
+		return nil
+	}
+}
+
+// MaxLength returns a Validator rejecting values longer than n characters.
+func MaxLength(n int) Validator {
+	return func(value string) error {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Errorf("Must be at most %d characters.", n)
+		}
+		return nil
+	}
+}
+
+// Pattern returns a Validator rejecting values that re does not match entirely,
+// with message as the error message.
+func Pattern(re *regexp.Regexp, message string) Validator {
+	anchored := regexp.MustCompile("^(?:" + re.String() + ")$")
+	return func(value string) error {
+		if value != "" && !anchored.MatchString(value) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// Range returns a Validator rejecting values that are not numbers between min
+// and max inclusive.
+func Range(min, max float64) Validator {
+	return func(value string) error {
+		if value == "" {
+			return nil
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		switch {
+		case err != nil:
+			return errors.New("Must be a number.")
+		case n < min:
+			return fmt.Errorf("Must be at least %s.", num(min))
+		case n > max:
+			return fmt.Errorf("Must be at most %s.", num(max))
+		}
+		return nil
+	}
+}
+
+// Email returns a Validator rejecting values that are not a bare email
+// address, such as gopher@example.com.
+func Email() Validator {
+	return func(value string) error {
+		if value == "" {
+			return nil
+		}
+		a, err := mail.ParseAddress(value)
+		if err != nil || a.Name != "" || a.Address != value ||
+			!strings.Contains(a.Address[strings.LastIndex(a.Address, "@"):],
+				".") {
+			return errors.New("Must be an email address.")
+		}
+		return nil
+	}
+}
+
+// Func returns a Validator rejecting the values for which valid returns false,
+// with message as the error message.
+func Func(valid func(value string) bool, message string) Validator {
+	return func(value string) error {
+		if !valid(value) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// Validate runs the validators of c on its raw value, as when the user leaves
+// the field, and reports whether the value is valid. If every Validator passes
+// the AsyncValidators are started, and Validate reports false until they
+// finish. Call it before using the value, for example when a form is
+// submitted.
+func (c *TF) Validate() bool {
+	c.validating = true
+	c.validate()
+	if c.MDC != nil && c.MDC.RootElement != nil {
+		vecty.Rerender(c)
+	}
+	return c.err == nil && !c.pending
+}
+
+// Err returns the error of the last validation of c, or nil if it was valid or
+// async validators are pending.
+func (c *TF) Err() error {
+	return c.err
+}
+
+// Pending reports whether async validators of c are running.
+func (c *TF) Pending() bool {
+	return c.pending
+}
+
+func (c *TF) validate() {
+	// Results of async validators of an earlier value are dropped.
+	c.seq++
+	c.err = nil
+	c.pending = false
+	for _, v := range c.Validators {
+		if c.err = v(c.Raw()); c.err != nil {
+			break
+		}
+	}
+	if c.err == nil && len(c.AsyncValidators) > 0 {
+		c.pending = true
+		go c.validateAsync(c.seq, c.Raw())
+	}
+	c.MDC.Restore("valid", c.err == nil)
+}
+
+func (c *TF) validateAsync(seq int, value string) {
+	var err error
+	for _, v := range c.AsyncValidators {
+		if err = v(value); err != nil {
+			break
+		}
+	}
+	if seq != c.seq {
+		return
+	}
+	c.err = err
+	c.pending = false
+	c.MDC.Restore("valid", c.err == nil)
+	vecty.Rerender(c)
+}
+
+func num(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -0,0 +1,32 @@
+package textfield_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/vecty-material/material/textfield"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		re    string
+		value string
+		valid bool
+	}{
+		{`[a-z]+`, "", true},
+		{`[a-z]+`, "abc", true},
+		{`[a-z]+`, "abc1", false},
+		{`[a-z]+`, "1abc", false},
+		// The leftmost match "a" does not span the value, but "ab" does.
+		{`a|ab`, "ab", true},
+		{`a|ab`, "abc", false},
+		{`^[a-z]+$`, "abc", true},
+	}
+	for _, tt := range tests {
+		err := textfield.Pattern(regexp.MustCompile(tt.re), "Invalid.")(tt.value)
+		if (err == nil) != tt.valid {
+			t.Errorf("Pattern(%q)(%q) = %v, want valid %v", tt.re, tt.value,
+				err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package toolbar_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/toolbar"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"fixed-start", &toolbar.T{SectionStart: vecty.List{toolbar.Title("Title", nil)}, Fixed: true}},
+		{"all-sections", &toolbar.T{SectionStart: vecty.List{toolbar.Title("Title", nil)}, SectionCenter: vecty.List{elem.Span(vecty.Text("Center"))}, SectionEnd: vecty.List{&icon.I{Name: "menu"}}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
@@ -1,13 +1,12 @@
 package ul
 
 import (
-	"syscall/js"
-
 	"github.com/gopherjs/vecty"
 	"github.com/gopherjs/vecty/elem"
 	"github.com/gopherjs/vecty/event"
 	"github.com/gopherjs/vecty/prop"
 	"github.com/vecty-material/material/base"
+	"github.com/vecty-material/material/base/applyer"
 )
 
 type nativeInputer interface {
@@ -18,13 +17,13 @@ type nativeInputer interface {
 type L struct {
 	*base.MDC
 	vecty.Core
-	Root           vecty.MarkupOrChild
-	Items          []vecty.ComponentOrHTML
-	Dense          bool
-	Avatar         bool
-	NonInteractive bool
-	OnClick        func(thisL *L, thisI *Item, e *vecty.Event)
-	GroupSubheader string
+	Root           vecty.MarkupOrChild                         `vecty:"prop"`
+	Items          []vecty.ComponentOrHTML                     `vecty:"prop"`
+	Dense          bool                                        `vecty:"prop"`
+	Avatar         bool                                        `vecty:"prop"`
+	NonInteractive bool                                        `vecty:"prop"`
+	OnClick        func(thisL *L, thisI *Item, e *vecty.Event) `vecty:"prop"`
+	GroupSubheader string                                      `vecty:"prop"`
 	twoLine        bool
 }
 
@@ -32,23 +31,23 @@ type L struct {
 type Item struct {
 	*base.MDC
 	vecty.Core
-	Root      vecty.MarkupOrChild
-	Primary   vecty.ComponentOrHTML
-	Secondary vecty.ComponentOrHTML
-	Graphic   vecty.ComponentOrHTML
-	Meta      vecty.ComponentOrHTML
-	Selected  bool
-	Activated bool
-	OnClick   func(i *Item, e *vecty.Event)
-	Href      string
+	Root      vecty.MarkupOrChild           `vecty:"prop"`
+	Primary   vecty.ComponentOrHTML         `vecty:"prop"`
+	Secondary vecty.ComponentOrHTML         `vecty:"prop"`
+	Graphic   vecty.ComponentOrHTML         `vecty:"prop"`
+	Meta      vecty.ComponentOrHTML         `vecty:"prop"`
+	Selected  bool                          `vecty:"prop"`
+	Activated bool                          `vecty:"prop"`
+	OnClick   func(i *Item, e *vecty.Event) `vecty:"prop"`
+	Href      string                        `vecty:"prop"`
 }
 
 // Group is a vecty-material list-group component.
 type Group struct {
 	*base.MDC
 	vecty.Core
-	Root  vecty.MarkupOrChild
-	Lists []vecty.ComponentOrHTML
+	Root  vecty.MarkupOrChild     `vecty:"prop"`
+	Lists []vecty.ComponentOrHTML `vecty:"prop"`
 }
 
 type divider struct {
@@ -82,7 +81,7 @@ func (c *L) Render() vecty.ComponentOrHTML {
 	root := elem.UnorderedList(items...)
 	vecty.Markup(
 		c,
-		vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+		vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 	).Apply(root)
 	return root
 }
@@ -152,7 +151,7 @@ func (c *Item) Render() vecty.ComponentOrHTML {
 	return vecty.Tag(tag,
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		graphic,
 		base.RenderStoredChild(text),
@@ -191,7 +190,7 @@ func (c *Group) Render() vecty.ComponentOrHTML {
 	return elem.Div(
 		vecty.Markup(
 			c,
-			vecty.MarkupIf(rootMarkup != nil, *rootMarkup),
+			vecty.MarkupIf(rootMarkup != nil, rootMarkup),
 		),
 		c.listList(),
 	)
@@ -282,7 +281,7 @@ func setupGraphicOrMeta(c vecty.ComponentOrHTML) vecty.ComponentOrHTML {
 	var graphic vecty.ComponentOrHTML
 	if c != nil {
 		graphic = c
-		if js.InternalObject(graphic).Get("tag").String() != "img" {
+		if h, ok := graphic.(*vecty.HTML); !ok || applyer.Tag(h) != "img" {
 			graphic = elem.Span(graphic)
 		}
 	}
@@ -0,0 +1,26 @@
+package ul_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/materialtest"
+	"github.com/vecty-material/material/ul"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		c    vecty.ComponentOrHTML
+	}{
+		{"list", &ul.L{Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("One")}, &ul.Item{Primary: vecty.Text("Two")}}}},
+		{"two-line-dense-avatar", &ul.L{Dense: true, Avatar: true, Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("Primary"), Secondary: vecty.Text("Secondary"), Graphic: &icon.I{Name: "folder"}, Meta: elem.Span(vecty.Text("info")), Selected: true}, ul.ItemDivider(), ul.ItemDividerInset()}}},
+		{"links-non-interactive", &ul.L{NonInteractive: true, Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("Home"), Href: "#home", Activated: true}, &ul.Item{Primary: vecty.Text("About"), Href: "#about"}}}},
+		{"group", &ul.Group{Lists: []vecty.ComponentOrHTML{&ul.L{GroupSubheader: "First", Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("One")}}}, &ul.L{GroupSubheader: "Second", Items: []vecty.ComponentOrHTML{&ul.Item{Primary: vecty.Text("Two")}}}}}},
+	}
+	for _, tt := range tests {
+		materialtest.Golden(t, tt.name, tt.c)
+	}
+}
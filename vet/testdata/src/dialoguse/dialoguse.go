@@ -0,0 +1,11 @@
+package dialoguse
+
+import (
+	"github.com/vecty-material/material/dialog"
+)
+
+var (
+	_ = &dialog.D{Header: "Title"}
+	_ = &dialog.D{}           // want `dialog.D without Header`
+	_ = &dialog.D{Header: ""} // want `dialog.D without Header`
+)
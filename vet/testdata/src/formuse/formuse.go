@@ -0,0 +1,22 @@
+package formuse
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/vecty-material/material/checkbox"
+	"github.com/vecty-material/material/formfield"
+	"github.com/vecty-material/material/icon"
+)
+
+var input = &icon.I{}
+
+var (
+	_ = &formfield.FF{Label: "ok", Input: &checkbox.CB{}}
+	_ = &formfield.FF{Label: "html", Input: elem.Input()}
+	_ = &formfield.FF{Label: "id", Input: elem.Div(vecty.Markup(prop.ID("d")))}
+	_ = &formfield.FF{Label: "unknown", Input: input}
+	_ = &formfield.FF{Label: "no id", Input: elem.Div()} // want `formfield.FF Input has no ID`
+	_ = &formfield.FF{Label: "no id", Input: &icon.I{}}  // want `formfield.FF Input has no ID`
+	_ = &formfield.FF{Label: "none"}                     // want `formfield.FF without Input`
+)
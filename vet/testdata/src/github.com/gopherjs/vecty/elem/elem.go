@@ -0,0 +1,7 @@
+package elem
+
+import "github.com/gopherjs/vecty"
+
+func Div(markup ...vecty.MarkupOrChild) *vecty.HTML { return &vecty.HTML{} }
+
+func Input(markup ...vecty.MarkupOrChild) *vecty.HTML { return &vecty.HTML{} }
@@ -0,0 +1,5 @@
+package prop
+
+import "github.com/gopherjs/vecty"
+
+func ID(id string) vecty.Applyer { return nil }
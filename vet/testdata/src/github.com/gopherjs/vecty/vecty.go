@@ -0,0 +1,19 @@
+package vecty
+
+type MarkupOrChild interface{}
+
+type ComponentOrHTML interface{}
+
+type Applyer interface {
+	Apply(h *HTML)
+}
+
+type MarkupList struct{}
+
+func Markup(m ...Applyer) MarkupList { return MarkupList{} }
+
+type HTML struct{}
+
+func Text(text string) *HTML { return &HTML{} }
+
+type List []ComponentOrHTML
@@ -0,0 +1,8 @@
+package button
+
+import "github.com/gopherjs/vecty"
+
+type B struct {
+	Root  vecty.MarkupOrChild
+	Label vecty.ComponentOrHTML
+}
@@ -0,0 +1,10 @@
+package checkbox
+
+import "github.com/gopherjs/vecty"
+
+type CB struct {
+	Root  vecty.MarkupOrChild
+	Input vecty.MarkupOrChild
+}
+
+func (c *CB) SetInputID(id string) {}
@@ -0,0 +1,9 @@
+package dialog
+
+import "github.com/gopherjs/vecty"
+
+type D struct {
+	Root   vecty.MarkupOrChild
+	Header string
+	Body   vecty.ComponentOrHTML
+}
@@ -0,0 +1,9 @@
+package formfield
+
+import "github.com/gopherjs/vecty"
+
+type FF struct {
+	Root  vecty.MarkupOrChild
+	Input vecty.ComponentOrHTML
+	Label string
+}
@@ -0,0 +1,10 @@
+package icon
+
+import "github.com/gopherjs/vecty"
+
+type I struct {
+	Root vecty.MarkupOrChild
+	Name string
+}
+
+func (c *I) Render() vecty.ComponentOrHTML { return nil }
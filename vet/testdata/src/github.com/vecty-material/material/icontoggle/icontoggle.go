@@ -0,0 +1,12 @@
+package icontoggle
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/vecty-material/material/icon"
+)
+
+type IT struct {
+	Root    vecty.MarkupOrChild
+	OnIcon  *icon.I
+	OffIcon *icon.I
+}
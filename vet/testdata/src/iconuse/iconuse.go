@@ -0,0 +1,13 @@
+package iconuse
+
+import (
+	"github.com/vecty-material/material/icon"
+	"github.com/vecty-material/material/icontoggle"
+)
+
+var (
+	_ = &icontoggle.IT{OnIcon: &icon.I{}, OffIcon: &icon.I{}}
+	_ = &icontoggle.IT{OnIcon: &icon.I{}}               // want `icontoggle.IT without OffIcon panics when rendered`
+	_ = &icontoggle.IT{OnIcon: nil, OffIcon: &icon.I{}} // want `icontoggle.IT without OnIcon panics when rendered`
+	_ = &icontoggle.IT{}                                // want `without OnIcon` `without OffIcon`
+)
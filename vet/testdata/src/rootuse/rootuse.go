@@ -0,0 +1,18 @@
+package rootuse
+
+import (
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/vecty-material/material/button"
+	"github.com/vecty-material/material/icon"
+)
+
+var root vecty.MarkupOrChild
+
+var (
+	_ = &button.B{Root: vecty.Markup()}
+	_ = &button.B{Root: root}
+	_ = &button.B{Root: elem.Div()}            // want `button.B Root is a child`
+	_ = &button.B{Root: vecty.List{}}          // want `button.B Root is a child`
+	_ = &button.B{Root: &icon.I{}, Label: nil} // want `button.B Root is a child`
+)
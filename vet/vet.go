@@ -0,0 +1,289 @@
+// vet provides go/analysis analyzers that find common misuse of vecty-material
+// components in user code, before it shows up at runtime. Run them with the
+// materialvet command:
+//
+//	go install github.com/vecty-material/material/cmd/materialvet
+//	go vet -vettool=$(which materialvet) ./...
+package vet // import "github.com/vecty-material/material/vet"
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	materialPath = "github.com/vecty-material/material/"
+	vectyPath    = "github.com/gopherjs/vecty"
+	elemPath     = "github.com/gopherjs/vecty/elem"
+	propPath     = "github.com/gopherjs/vecty/prop"
+)
+
+// Analyzers are all the analyzers of this package.
+var Analyzers = []*analysis.Analyzer{
+	IconToggle,
+	FormField,
+	Dialog,
+	Root,
+}
+
+// IconToggle reports icontoggle.IT literals without OnIcon or OffIcon, which
+// panic when rendered.
+var IconToggle = &analysis.Analyzer{
+	Name:     "icontoggle",
+	Doc:      "report icontoggle.IT literals missing OnIcon or OffIcon",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		eachLiteral(pass, "icontoggle", "IT", func(lit *ast.CompositeLit) {
+			for _, f := range []string{"OnIcon", "OffIcon"} {
+				if v := field(lit, f); v == nil || isNil(pass, v) {
+					pass.Reportf(lit.Pos(), "icontoggle.IT without %s "+
+						"panics when rendered", f)
+				}
+			}
+		})
+		return nil, nil
+	},
+}
+
+// FormField reports formfield.FF literals without an Input, whose label then
+// labels nothing, and those whose Input has no ID and cannot be given one by
+// the FF. The FF allocates an ID for inputs that implement SetInputID, such as
+// checkbox.CB, and for input, select and textarea elements.
+var FormField = &analysis.Analyzer{
+	Name:     "formfield",
+	Doc:      "report formfield.FF literals without Input or whose Input has no ID",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		eachLiteral(pass, "formfield", "FF", func(lit *ast.CompositeLit) {
+			input := field(lit, "Input")
+			if input == nil {
+				pass.Reportf(lit.Pos(), "formfield.FF without Input has "+
+					"a label for nothing")
+				return
+			}
+			if literalOf(input) == nil && !isCall(input) {
+				// A variable or function result, which cannot be checked.
+				return
+			}
+			if autoID(pass, input) || hasID(pass, input) {
+				return
+			}
+			pass.Reportf(lit.Pos(), "formfield.FF Input has no ID and "+
+				"cannot be given one, so its label is not associated "+
+				"with it; set one with prop.ID")
+		})
+		return nil, nil
+	},
+}
+
+// Dialog reports dialog.D literals without a Header, as the dialog then has no
+// title for aria-labelledby to refer to.
+var Dialog = &analysis.Analyzer{
+	Name:     "dialog",
+	Doc:      "report dialog.D literals without Header",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		eachLiteral(pass, "dialog", "D", func(lit *ast.CompositeLit) {
+			if h := field(lit, "Header"); h == nil || isEmptyString(pass, h) {
+				pass.Reportf(lit.Pos(), "dialog.D without Header has no "+
+					"title for assistive technology")
+			}
+		})
+		return nil, nil
+	},
+}
+
+// Root reports vecty-material component literals whose Root is an element or
+// component, which replaces all of the component's built-in markup.
+var Root = &analysis.Analyzer{
+	Name: "root",
+	Doc: "report vecty-material components with Root set to a child " +
+		"instead of markup",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run: func(pass *analysis.Pass) (interface{}, error) {
+		ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+		ins.Preorder([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node) {
+			lit := n.(*ast.CompositeLit)
+			name, ok := materialType(pass.TypesInfo.TypeOf(lit))
+			if !ok || !hasField(pass, lit, "Root") {
+				return
+			}
+			r := field(lit, "Root")
+			if r == nil || !isChild(pass.TypesInfo.TypeOf(r)) {
+				return
+			}
+			pass.Reportf(r.Pos(), "%s Root is a child, which replaces all "+
+				"of its built-in markup; use vecty.Markup to add to the "+
+				"built-in root element", name)
+		})
+		return nil, nil
+	},
+}
+
+// eachLiteral calls fn for each composite literal of the vecty-material type
+// pkg.name in the package being analyzed.
+func eachLiteral(pass *analysis.Pass, pkg, name string,
+	fn func(lit *ast.CompositeLit)) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		lit := n.(*ast.CompositeLit)
+		if len(lit.Elts) > 0 {
+			if _, ok := lit.Elts[0].(*ast.KeyValueExpr); !ok {
+				// Unkeyed fields, every field is set.
+				return
+			}
+		}
+		if t, ok := materialType(pass.TypesInfo.TypeOf(lit)); ok &&
+			t == pkg+"."+name {
+			fn(lit)
+		}
+	})
+}
+
+// materialType returns the name, for example "dialog.D", of t if it is a named
+// type declared in a vecty-material package.
+func materialType(t types.Type) (string, bool) {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	n, ok := t.(*types.Named)
+	if !ok || n.Obj().Pkg() == nil {
+		return "", false
+	}
+	path := n.Obj().Pkg().Path()
+	if !strings.HasPrefix(path, materialPath) ||
+		strings.HasPrefix(path, materialPath+"material/") {
+		return "", false
+	}
+	return n.Obj().Pkg().Name() + "." + n.Obj().Name(), true
+}
+
+// field returns the value of the keyed field name in lit, or nil.
+func field(lit *ast.CompositeLit, name string) ast.Expr {
+	for _, e := range lit.Elts {
+		kv, ok := e.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if id, ok := kv.Key.(*ast.Ident); ok && id.Name == name {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// hasField reports whether the type of lit has a field name.
+func hasField(pass *analysis.Pass, lit *ast.CompositeLit, name string) bool {
+	t := pass.TypesInfo.TypeOf(lit)
+	obj, _, _ := types.LookupFieldOrMethod(t, true, pass.Pkg, name)
+	_, ok := obj.(*types.Var)
+	return ok
+}
+
+// literalOf returns the composite literal e is, or points to, or nil.
+func literalOf(e ast.Expr) *ast.CompositeLit {
+	if u, ok := e.(*ast.UnaryExpr); ok {
+		e = u.X
+	}
+	lit, _ := e.(*ast.CompositeLit)
+	return lit
+}
+
+func isCall(e ast.Expr) bool {
+	_, ok := e.(*ast.CallExpr)
+	return ok
+}
+
+// autoID reports whether formfield.FF gives the input e an ID when it has
+// none: e implements SetInputID, or is a call creating an input, select or
+// textarea element.
+func autoID(pass *analysis.Pass, e ast.Expr) bool {
+	t := pass.TypesInfo.TypeOf(e)
+	if t == nil {
+		return false
+	}
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		// The dynamic type is unknown.
+		return true
+	}
+	if types.NewMethodSet(t).Lookup(nil, "SetInputID") != nil {
+		return true
+	}
+	call, ok := e.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	f := calledFunc(pass, call)
+	if f == nil || f.Pkg() == nil || f.Pkg().Path() != elemPath {
+		return false
+	}
+	switch f.Name() {
+	case "Input", "Select", "TextArea":
+		return true
+	}
+	return false
+}
+
+// hasID reports whether e contains a call to prop.ID.
+func hasID(pass *analysis.Pass, e ast.Expr) bool {
+	found := false
+	ast.Inspect(e, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && !found {
+			f := calledFunc(pass, call)
+			found = f != nil && f.Pkg() != nil &&
+				f.Pkg().Path() == propPath && f.Name() == "ID"
+		}
+		return !found
+	})
+	return found
+}
+
+// calledFunc returns the package level func call calls, or nil.
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	f, _ := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	return f
+}
+
+// isChild reports whether t is a vecty element, list or component rather than
+// markup.
+func isChild(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		// The dynamic type is unknown.
+		return false
+	}
+	named := t
+	if p, ok := t.(*types.Pointer); ok {
+		named = p.Elem()
+	}
+	if n, ok := named.(*types.Named); ok && n.Obj().Pkg() != nil &&
+		n.Obj().Pkg().Path() == vectyPath {
+		switch n.Obj().Name() {
+		case "HTML", "List", "KeyedList":
+			return true
+		}
+		return false
+	}
+	ms := types.NewMethodSet(t)
+	return ms.Lookup(nil, "Render") != nil
+}
+
+func isNil(pass *analysis.Pass, e ast.Expr) bool {
+	return pass.TypesInfo.Types[e].IsNil()
+}
+
+func isEmptyString(pass *analysis.Pass, e ast.Expr) bool {
+	tv := pass.TypesInfo.Types[e]
+	return tv.Value != nil && tv.Value.ExactString() == `""`
+}
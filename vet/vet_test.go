@@ -0,0 +1,25 @@
+package vet_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/vecty-material/material/vet"
+)
+
+func TestIconToggle(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), vet.IconToggle, "iconuse")
+}
+
+func TestFormField(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), vet.FormField, "formuse")
+}
+
+func TestDialog(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), vet.Dialog, "dialoguse")
+}
+
+func TestRoot(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), vet.Root, "rootuse")
+}